@@ -0,0 +1,205 @@
+package v1alpha1
+
+// KubeSecretReference points at a Kubernetes Secret holding a piece of
+// authentication material (a service token, universal-auth credentials, etc).
+type KubeSecretReference struct {
+	SecretName      string `json:"secretName"`
+	SecretNamespace string `json:"secretNamespace"`
+}
+
+// CaReference points at a Kubernetes Secret key holding a PEM-encoded CA
+// certificate used to verify the Infisical server.
+type CaReference struct {
+	SecretName      string `json:"secretName,omitempty"`
+	SecretNamespace string `json:"secretNamespace,omitempty"`
+	Key             string `json:"key,omitempty"`
+}
+
+// TLSConfig controls how the operator talks TLS to the Infisical API.
+type TLSConfig struct {
+	// CaRef, when set, verifies the Infisical server against a custom CA
+	// instead of the system trust store.
+	// +optional
+	CaRef CaReference `json:"caRef,omitempty"`
+	// ClientCertRef names a kubernetes.io/tls Secret whose "tls.crt" key
+	// holds the client certificate presented for mTLS. Must be set together
+	// with ClientKeyRef.
+	// +optional
+	ClientCertRef KubeSecretReference `json:"clientCertRef,omitempty"`
+	// ClientKeyRef names a kubernetes.io/tls Secret whose "tls.key" key
+	// holds the private key matching ClientCertRef.
+	// +optional
+	ClientKeyRef KubeSecretReference `json:"clientKeyRef,omitempty"`
+	// ServerName overrides the server name used to verify the Infisical
+	// server's certificate and for SNI, for self-hosted deployments fronted
+	// by a gateway that doesn't share the certificate's subject.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+	// MinVersion is the minimum TLS version to negotiate, one of "1.2" or
+	// "1.3". Defaults to the Go standard library's default (currently 1.2).
+	// +optional
+	// +kubebuilder:validation:Enum=1.2;1.3
+	MinVersion string `json:"minVersion,omitempty"`
+	// CipherSuites restricts the negotiated cipher suite to this list, named
+	// per Go's crypto/tls.CipherSuites(). Ignored for TLS 1.3, which only
+	// negotiates its own suite set.
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+// MachineIdentityScopeInWorkspace scopes a machine-identity based auth method
+// (universal-auth, kubernetes-auth, ...) to an environment/secret path.
+type MachineIdentityScopeInWorkspace struct {
+	EnvSlug   string `json:"envSlug,omitempty"`
+	SecretPath string `json:"secretPath,omitempty"`
+	Recursive bool   `json:"recursive,omitempty"`
+}
+
+// SecretScopeInWorkspace scopes a service-token based auth method to an
+// environment/secret path.
+type SecretScopeInWorkspace struct {
+	SecretPath string `json:"secretPath,omitempty"`
+	EnvSlug    string `json:"envSlug,omitempty"`
+	// Selector, when set, has the push/sync target watch every Secret and
+	// ConfigMap matching it in the namespace instead of a single fixed source.
+	// Nothing in this tree evaluates it; see SecretSelector.
+	// +optional
+	Selector *SecretSelector `json:"selector,omitempty"`
+}
+
+// ServiceTokenDetails configures the legacy service-token authentication mode.
+type ServiceTokenDetails struct {
+	ServiceTokenSecretReference KubeSecretReference    `json:"serviceTokenSecretReference,omitempty"`
+	SecretsScope                SecretScopeInWorkspace `json:"secretsScope,omitempty"`
+}
+
+// ServiceAccountDetails configures the legacy service-account authentication mode.
+type ServiceAccountDetails struct {
+	ServiceAccountSecretReference KubeSecretReference `json:"serviceAccountSecretReference,omitempty"`
+	ProjectId                     string              `json:"projectId,omitempty"`
+}
+
+// UniversalAuthDetails configures universal-auth (clientId/clientSecret)
+// machine-identity authentication.
+type UniversalAuthDetails struct {
+	CredentialsRef KubeSecretReference             `json:"credentialsRef,omitempty"`
+	SecretsScope   MachineIdentityScopeInWorkspace `json:"secretsScope,omitempty"`
+	IdentityID     string                          `json:"identityId,omitempty"`
+}
+
+// KubernetesServiceAccountRef names the in-cluster ServiceAccount whose
+// projected token is exchanged for an Infisical access token.
+type KubernetesServiceAccountRef struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// KubernetesAuthDetails configures the Kubernetes-native auth flow, where a
+// projected ServiceAccount JWT is exchanged for a short-lived Infisical
+// machine-identity access token via POST /api/v1/auth/kubernetes-auth/login.
+type KubernetesAuthDetails struct {
+	IdentityID        string                          `json:"identityId,omitempty"`
+	ServiceAccountRef KubernetesServiceAccountRef     `json:"serviceAccountRef,omitempty"`
+	SecretsScope      MachineIdentityScopeInWorkspace `json:"secretsScope,omitempty"`
+	// Audience is the intended audience of the projected ServiceAccount
+	// token. Defaults to "infisical" when unset.
+	// +optional
+	Audience string `json:"audience,omitempty"`
+	// TokenPath, when set, reads a pre-mounted projected token from this
+	// path instead of requesting one via the TokenRequest API.
+	// +optional
+	TokenPath string `json:"tokenPath,omitempty"`
+}
+
+// AWSIamAuthDetails configures AWS IAM machine-identity authentication.
+type AWSIamAuthDetails struct {
+	IdentityID   string                          `json:"identityId,omitempty"`
+	SecretsScope MachineIdentityScopeInWorkspace `json:"secretsScope,omitempty"`
+}
+
+// AzureAuthDetails configures Azure machine-identity authentication.
+type AzureAuthDetails struct {
+	IdentityID   string                          `json:"identityId,omitempty"`
+	SecretsScope MachineIdentityScopeInWorkspace `json:"secretsScope,omitempty"`
+}
+
+// GCPIdTokenAuthDetails configures GCP ID-token machine-identity authentication.
+type GCPIdTokenAuthDetails struct {
+	IdentityID   string                          `json:"identityId,omitempty"`
+	SecretsScope MachineIdentityScopeInWorkspace `json:"secretsScope,omitempty"`
+}
+
+// GcpIamAuthDetails configures GCP IAM machine-identity authentication.
+type GcpIamAuthDetails struct {
+	IdentityID                string                          `json:"identityId,omitempty"`
+	ServiceAccountKeyFilePath string                          `json:"serviceAccountKeyFilePath,omitempty"`
+	SecretsScope              MachineIdentityScopeInWorkspace `json:"secretsScope,omitempty"`
+}
+
+// Authentication is the set of authentication modes an InfisicalSecret or
+// InfisicalDynamicSecret can use to talk to the Infisical API.
+type Authentication struct {
+	ServiceAccount ServiceAccountDetails `json:"serviceAccount,omitempty"`
+	ServiceToken   ServiceTokenDetails   `json:"serviceToken,omitempty"`
+	UniversalAuth  UniversalAuthDetails  `json:"universalAuth,omitempty"`
+	KubernetesAuth KubernetesAuthDetails `json:"kubernetesAuth,omitempty"`
+	AwsIamAuth     AWSIamAuthDetails     `json:"awsIamAuth,omitempty"`
+	AzureAuth      AzureAuthDetails      `json:"azureAuth,omitempty"`
+	GcpIdTokenAuth GCPIdTokenAuthDetails `json:"gcpIdTokenAuth,omitempty"`
+	GcpIamAuth     GcpIamAuthDetails     `json:"gcpIamAuth,omitempty"`
+	// ExecAuth authenticates by invoking an external command and reading an
+	// ExecCredential-style response from its stdout, mirroring client-go's
+	// exec credential plugin mechanism. No reconciler in this tree reads this
+	// field; see ExecAuthDetails.
+	// +optional
+	ExecAuth ExecAuthDetails `json:"execAuth,omitempty"`
+}
+
+// GenericUniversalAuth is the universal-auth shape used by resources (such as
+// InfisicalPushSecret) that talk directly to a workspace rather than through
+// the Authentication struct.
+type GenericUniversalAuth struct {
+	CredentialsRef KubeSecretReference `json:"credentialsRef,omitempty"`
+	IdentityID     string              `json:"identityId,omitempty"`
+}
+
+// GenericKubernetesAuth is the Kubernetes-auth shape used by resources that
+// talk directly to a workspace rather than through the Authentication struct.
+type GenericKubernetesAuth struct {
+	IdentityID        string                      `json:"identityId,omitempty"`
+	ServiceAccountRef KubernetesServiceAccountRef `json:"serviceAccountRef,omitempty"`
+}
+
+// GenericAwsIamAuth is the AWS IAM auth shape used outside of Authentication.
+type GenericAwsIamAuth struct {
+	IdentityID string `json:"identityId,omitempty"`
+}
+
+// GenericAzureAuth is the Azure auth shape used outside of Authentication.
+type GenericAzureAuth struct {
+	IdentityID string `json:"identityId,omitempty"`
+}
+
+// GenericGcpIdTokenAuth is the GCP ID-token auth shape used outside of Authentication.
+type GenericGcpIdTokenAuth struct {
+	IdentityID string `json:"identityId,omitempty"`
+}
+
+// GenericGcpIamAuth is the GCP IAM auth shape used outside of Authentication.
+type GenericGcpIamAuth struct {
+	IdentityID                string `json:"identityId,omitempty"`
+	ServiceAccountKeyFilePath string `json:"serviceAccountKeyFilePath,omitempty"`
+}
+
+// GenericInfisicalAuthentication mirrors Authentication for resources that
+// authenticate against a single workspace directly.
+type GenericInfisicalAuthentication struct {
+	UniversalAuth  GenericUniversalAuth  `json:"universalAuth,omitempty"`
+	KubernetesAuth GenericKubernetesAuth `json:"kubernetesAuth,omitempty"`
+	AwsIamAuth     GenericAwsIamAuth     `json:"awsIamAuth,omitempty"`
+	AzureAuth      GenericAzureAuth      `json:"azureAuth,omitempty"`
+	GcpIdTokenAuth GenericGcpIdTokenAuth `json:"gcpIdTokenAuth,omitempty"`
+	GcpIamAuth     GenericGcpIamAuth     `json:"gcpIamAuth,omitempty"`
+	// +optional
+	ExecAuth ExecAuthDetails `json:"execAuth,omitempty"`
+}
@@ -0,0 +1,44 @@
+package v1alpha1
+
+// EnvVar is a simple name/value pair injected into an ExecAuth plugin's
+// environment, modeled on client-go's exec credential plugin EnvVar.
+type EnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ExecAuthDetails configures authentication via an external command that
+// implements the Kubernetes client-go exec credential plugin protocol: the
+// operator invokes Command with Args and Env, reads a JSON ExecCredential
+// response from its stdout containing either a raw Infisical access token
+// (with optional expiry) or universal-auth clientId/clientSecret, and caches
+// the result in-memory until it expires.
+//
+// No reconciler in this tree reads Authentication.ExecAuth yet (see
+// common.ExecAuthCache), so setting this field currently has no effect on
+// an InfisicalSecret or InfisicalDynamicSecret.
+type ExecAuthDetails struct {
+	// Command is the path to the executable the operator invokes to obtain
+	// credentials, e.g. a vendor CLI or a corporate SSO wrapper.
+	Command string `json:"command,omitempty"`
+	// Args are passed to Command as-is.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env is appended to the command's environment in addition to the
+	// process's own environment.
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+	// APIVersion is the ExecCredential API version the plugin is expected to
+	// speak, e.g. "client.authentication.k8s.io/v1".
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+	// InstallHint is surfaced in error messages when Command cannot be found
+	// or executed, to help operators install the right plugin.
+	// +optional
+	InstallHint string `json:"installHint,omitempty"`
+	// InteractiveMode mirrors the client-go field of the same name. Exec
+	// auth plugins run unattended by the operator, so this is always
+	// "Never".
+	// +optional
+	InteractiveMode string `json:"interactiveMode,omitempty"`
+}
@@ -0,0 +1,104 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// LeaseRenewalPolicy controls how a dynamic secret lease is kept alive past
+// its initial TTL.
+// +kubebuilder:validation:Enum=Renew;Recreate
+type LeaseRenewalPolicy string
+
+const (
+	// LeaseRenewalPolicyRenew extends the existing lease in place.
+	LeaseRenewalPolicyRenew LeaseRenewalPolicy = "Renew"
+	// LeaseRenewalPolicyRecreate revokes the existing lease and issues a new one.
+	LeaseRenewalPolicyRecreate LeaseRenewalPolicy = "Recreate"
+)
+
+// WebhookHook fires an HTTPS request carrying HMAC-signed lease metadata
+// (never the credential itself) at a lease-lifecycle boundary.
+type WebhookHook struct {
+	URL    string `json:"url"`
+	Method string `json:"method,omitempty"`
+	// Headers are rendered as Go templates against the lease metadata before
+	// being sent, so e.g. `{{ .LeaseId }}` can be embedded in a header value.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// CaRef verifies the webhook endpoint for self-hosted/mTLS-enforcing
+	// gateways.
+	// +optional
+	CaRef *CaReference `json:"caRef,omitempty"`
+	// HMACSecretRef names a Secret whose "hmacSecret" data key is the shared
+	// secret the request body is HMAC-SHA256 signed with, surfaced in the
+	// X-Infisical-Signature header.
+	// +optional
+	HMACSecretRef *KubeSecretReference `json:"hmacSecretRef,omitempty"`
+}
+
+// JobHook spawns a Kubernetes Job at a lease-lifecycle boundary, with the
+// lease metadata injected via envFrom.
+type JobHook struct {
+	// Namespace the Job is created in. Defaults to the InfisicalDynamicSecret's
+	// own namespace when unset.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Template is a batch/v1 Job template, embedded as raw JSON/YAML so this
+	// package doesn't need to depend on k8s.io/api/batch/v1 directly.
+	Template runtime.RawExtension `json:"template"`
+}
+
+// RollingRestartHook patches an annotation on every Deployment/StatefulSet
+// matching Selector to trigger a rolling restart, so consumers pick up a
+// rotated credential without waiting for their own reconcile.
+type RollingRestartHook struct {
+	// Kind is either "Deployment" or "StatefulSet".
+	Kind string `json:"kind"`
+	// Namespace defaults to the InfisicalDynamicSecret's own namespace when unset.
+	// +optional
+	Namespace string               `json:"namespace,omitempty"`
+	Selector  metav1.LabelSelector `json:"selector"`
+}
+
+// LeaseHook is one action to run at a lease-lifecycle boundary. Exactly one
+// of Webhook, Job, or RollingRestart should be set.
+type LeaseHook struct {
+	// Name identifies this hook in InfisicalDynamicSecretStatus.HookConditions.
+	Name string `json:"name,omitempty"`
+	// +optional
+	Webhook *WebhookHook `json:"webhook,omitempty"`
+	// +optional
+	Job *JobHook `json:"job,omitempty"`
+	// +optional
+	RollingRestart *RollingRestartHook `json:"rollingRestart,omitempty"`
+}
+
+// DynamicSecretHooks fires user-defined actions at lease boundaries.
+//
+// No InfisicalDynamicSecret reconciler exists in this tree yet to create
+// leases or fire hooks at their boundaries (the building blocks live in
+// common.BuildWebhookRequest/BuildJobForHook/ApplyRollingRestart with no
+// caller), so setting this field currently has no effect and
+// InfisicalDynamicSecretStatus.HookConditions is never populated.
+type DynamicSecretHooks struct {
+	// +optional
+	OnLeaseCreated []LeaseHook `json:"onLeaseCreated,omitempty"`
+	// +optional
+	OnLeaseRenewed []LeaseHook `json:"onLeaseRenewed,omitempty"`
+	// +optional
+	OnLeaseRevoked []LeaseHook `json:"onLeaseRevoked,omitempty"`
+	// +optional
+	OnLeaseExpired []LeaseHook `json:"onLeaseExpired,omitempty"`
+}
+
+// HookExecutionStatus records the outcome of the most recent run of a
+// single named hook.
+type HookExecutionStatus struct {
+	HookName string `json:"hookName"`
+	// +optional
+	LastExecutionTime *metav1.Time `json:"lastExecutionTime,omitempty"`
+	Succeeded         bool         `json:"succeeded"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthIssuerReference points an InfisicalSecret at an
+// InfisicalAuthIssuer to source authentication from, instead of declaring its
+// own Authentication block. Namespace defaults to the referencing resource's
+// own namespace when unset.
+type AuthIssuerReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AuthIssuerFrontend is the set of authentication modes an
+// InfisicalAuthIssuer can use to talk to the Infisical API. Exactly one
+// field should be set.
+type AuthIssuerFrontend struct {
+	// +optional
+	UniversalAuth *UniversalAuthDetails `json:"universalAuth,omitempty"`
+	// +optional
+	ServiceToken *ServiceTokenDetails `json:"serviceToken,omitempty"`
+	// +optional
+	ServiceAccount *ServiceAccountDetails `json:"serviceAccount,omitempty"`
+	// +optional
+	KubernetesAuth *KubernetesAuthDetails `json:"kubernetesAuth,omitempty"`
+	// +optional
+	AwsIamAuth *AWSIamAuthDetails `json:"awsIamAuth,omitempty"`
+}
+
+// InfisicalAuthIssuerSpec defines the desired state of InfisicalAuthIssuer.
+type InfisicalAuthIssuerSpec struct {
+	Frontend AuthIssuerFrontend `json:"frontend,omitempty"`
+	TLS      TLSConfig          `json:"tls,omitempty"`
+}
+
+// InfisicalAuthIssuerStatus defines the observed state of InfisicalAuthIssuer.
+type InfisicalAuthIssuerStatus struct {
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// LastSuccessfulLogin records when Frontend was last exchanged for a
+	// working Infisical access token.
+	// +optional
+	LastSuccessfulLogin *metav1.Time `json:"lastSuccessfulLogin,omitempty"`
+	// TokenExpiresAt records when the most recently issued access token
+	// expires, so referring resources know when to expect a refresh.
+	// +optional
+	TokenExpiresAt *metav1.Time `json:"tokenExpiresAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+
+// InfisicalAuthIssuer decouples authentication configuration from individual
+// InfisicalSecret resources, so a single set of
+// credentials can be shared (and rotated/re-reconciled) across many of them
+// via AuthIssuerRef.
+type InfisicalAuthIssuer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InfisicalAuthIssuerSpec   `json:"spec,omitempty"`
+	Status InfisicalAuthIssuerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InfisicalAuthIssuerList contains a list of InfisicalAuthIssuer.
+type InfisicalAuthIssuerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InfisicalAuthIssuer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InfisicalAuthIssuer{}, &InfisicalAuthIssuerList{})
+}
@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DynamicSecretDetails identifies the Infisical dynamic secret lease
+// definition to draw leases from.
+type DynamicSecretDetails struct {
+	SecretsPath     string `json:"secretsPath,omitempty"`
+	EnvironmentSlug string `json:"environmentSlug,omitempty"`
+	ProjectSlug     string `json:"projectSlug,omitempty"`
+	Name            string `json:"name,omitempty"`
+}
+
+// InfisicalDynamicSecretLease records the most recently issued lease.
+type InfisicalDynamicSecretLease struct {
+	LeaseId           string      `json:"leaseId,omitempty"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty"`
+	ExpiresAt         metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// InfisicalDynamicSecretSpec defines the desired state of InfisicalDynamicSecret.
+type InfisicalDynamicSecretSpec struct {
+	ManagedSecretReference ManagedKubeSecretConfig `json:"managedSecretReference,omitempty"`
+	Authentication         Authentication          `json:"authentication,omitempty"`
+	DynamicSecret          DynamicSecretDetails    `json:"dynamicSecret,omitempty"`
+	TLS                    TLSConfig               `json:"tls,omitempty"`
+	// Hooks fires user-defined actions at lease-lifecycle boundaries. Nothing
+	// in this tree fires them; see DynamicSecretHooks.
+	// +optional
+	Hooks *DynamicSecretHooks `json:"hooks,omitempty"`
+	// RenewalPolicy controls how the lease is kept alive past its initial
+	// TTL. Defaults to LeaseRenewalPolicyRenew.
+	// +optional
+	RenewalPolicy LeaseRenewalPolicy `json:"renewalPolicy,omitempty"`
+	// PreExpiryWindow triggers lease rotation this long before the current
+	// lease's ExpiresAt, instead of waiting for it to actually expire.
+	// +optional
+	PreExpiryWindow metav1.Duration `json:"preExpiryWindow,omitempty"`
+}
+
+// InfisicalDynamicSecretStatus defines the observed state of InfisicalDynamicSecret.
+type InfisicalDynamicSecretStatus struct {
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +optional
+	Lease *InfisicalDynamicSecretLease `json:"lease,omitempty"`
+	// HookConditions records the most recent outcome of every named hook in
+	// Spec.Hooks. See DynamicSecretHooks: nothing populates this field yet.
+	// +optional
+	HookConditions []HookExecutionStatus `json:"hookConditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+
+// InfisicalDynamicSecret leases dynamic (databases, cloud IAM, ...) secrets
+// from Infisical and keeps a Kubernetes Secret populated with an active lease.
+type InfisicalDynamicSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InfisicalDynamicSecretSpec   `json:"spec,omitempty"`
+	Status InfisicalDynamicSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InfisicalDynamicSecretList contains a list of InfisicalDynamicSecret.
+type InfisicalDynamicSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InfisicalDynamicSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InfisicalDynamicSecret{}, &InfisicalDynamicSecretList{})
+}
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InfisicalPushSecretDestination identifies the Infisical workspace location
+// an InfisicalPushSecret writes into.
+type InfisicalPushSecretDestination struct {
+	ProjectSlug string `json:"projectSlug,omitempty"`
+	EnvSlug     string `json:"envSlug,omitempty"`
+	SecretPath  string `json:"secretPath,omitempty"`
+}
+
+// SecretPush identifies the Kubernetes Secret(s) whose keys are pushed to Infisical.
+type SecretPush struct {
+	Secret SecretScopeInWorkspace `json:"secret,omitempty"`
+	// Selector, when set, watches every Secret/ConfigMap matching it in the
+	// namespace and continuously pushes their union, instead of a single
+	// fixed Secret named by Secret. Nothing in this tree evaluates it; see
+	// SecretSelector.
+	// +optional
+	Selector *SecretSelector `json:"selector,omitempty"`
+	// ConflictPolicy governs what happens when two selected sources
+	// contribute the same key. Defaults to "Error" when unset.
+	// +optional
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+	// DeletionPolicy governs whether upstream keys are removed once their
+	// source Secret/ConfigMap disappears. Defaults to "Retain" when unset.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// InfisicalPushSecretSpec defines the desired state of InfisicalPushSecret.
+type InfisicalPushSecretSpec struct {
+	Destination    InfisicalPushSecretDestination `json:"destination,omitempty"`
+	Authentication GenericInfisicalAuthentication `json:"authentication,omitempty"`
+	Push           SecretPush                     `json:"push,omitempty"`
+	TLS            TLSConfig                      `json:"tls,omitempty"`
+}
+
+// InfisicalPushSecretStatus defines the observed state of InfisicalPushSecret.
+type InfisicalPushSecretStatus struct {
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ManagedSecrets maps each pushed key to the Infisical secret ID it was
+	// written as, so the operator can detect and clean up renamed/removed keys.
+	// +optional
+	ManagedSecrets map[string]string `json:"managedSecrets,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+
+// InfisicalPushSecret pushes the keys of a Kubernetes Secret into an
+// Infisical project/environment/path.
+type InfisicalPushSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InfisicalPushSecretSpec   `json:"spec,omitempty"`
+	Status InfisicalPushSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InfisicalPushSecretList contains a list of InfisicalPushSecret.
+type InfisicalPushSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InfisicalPushSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InfisicalPushSecret{}, &InfisicalPushSecretList{})
+}
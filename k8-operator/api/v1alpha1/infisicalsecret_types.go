@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InfisicalSecretTemplate lets the managed Kubernetes Secret's data be
+// derived from the fetched Infisical secrets instead of copied verbatim.
+type InfisicalSecretTemplate struct {
+	// TemplateEngine selects how Data/TemplateFrom values are rendered.
+	// Defaults to "None" (copied verbatim) when unset.
+	// +optional
+	TemplateEngine TemplateEngine `json:"templateEngine,omitempty"`
+	// Data holds, per managed-secret key, either a literal value (when
+	// TemplateEngine is "None") or a Go text/template expression evaluated
+	// against the fetched secrets (when TemplateEngine is "Go"), exposed as
+	// .Secrets.<KEY>.Value, .Secrets.<KEY>.Comment, .Labels and .Annotations.
+	// See common.templateFuncMap for the curated function set: note that
+	// "pkcs12" (building a Java keystore from a cert/key pair) was part of
+	// the original ask but isn't offered, since no PKCS#12 encoder is
+	// vendored in this build - tracked as an open gap, not a silent drop.
+	// +optional
+	Data map[string]string `json:"data,omitempty"`
+	// TemplateFrom loads the template data from a ConfigMap or Secret key
+	// instead of (or in addition to) Data, for templates too large to inline.
+	// +optional
+	TemplateFrom *TemplateSource `json:"templateFrom,omitempty"`
+}
+
+// ManagedKubeSecretConfig configures the Kubernetes Secret the operator
+// writes fetched values into.
+type ManagedKubeSecretConfig struct {
+	SecretName      string `json:"secretName,omitempty"`
+	SecretNamespace string `json:"secretNamespace,omitempty"`
+	// CreationPolicy controls whether the operator expects the Secret to
+	// already exist ("Owner") or creates it itself ("Orphan").
+	// +optional
+	CreationPolicy string `json:"creationPolicy,omitempty"`
+	// Template, when set, renders the managed Secret's data from the
+	// fetched Infisical secrets instead of copying them verbatim.
+	// +optional
+	Template *InfisicalSecretTemplate `json:"template,omitempty"`
+}
+
+// InfisicalSecretSpec defines the desired state of InfisicalSecret.
+type InfisicalSecretSpec struct {
+	// TokenSecretReference is deprecated in favor of Authentication, kept
+	// for backwards compatibility with service-token-only installs.
+	// +optional
+	TokenSecretReference   KubeSecretReference     `json:"tokenSecretReference,omitempty"`
+	Authentication         Authentication          `json:"authentication,omitempty"`
+	ManagedSecretReference ManagedKubeSecretConfig `json:"managedSecretReference,omitempty"`
+	TLS                    TLSConfig               `json:"tls,omitempty"`
+	// AuthIssuerRef, when set, reads authentication configuration from the
+	// named InfisicalAuthIssuer instead of Authentication, so multiple
+	// InfisicalSecrets can share one set of credentials. Apart from
+	// MapAuthIssuerToInfisicalSecrets, nothing in this tree reads this field.
+	// +optional
+	AuthIssuerRef *AuthIssuerReference `json:"authIssuerRef,omitempty"`
+	// Sources, when set, merges one or more additional Infisical
+	// scopes/environments into this InfisicalSecret's managed Secret,
+	// selected by environment, folder path glob, or tag, with per-source
+	// Prefix/Priority for deterministic merging. Nothing in this tree
+	// performs that merge; see SecretSourceSelector.
+	// +optional
+	Sources []SecretSourceSelector `json:"sources,omitempty"`
+}
+
+// InfisicalSecretStatus defines the observed state of InfisicalSecret.
+type InfisicalSecretStatus struct {
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+
+// InfisicalSecret syncs secrets from an Infisical project into a Kubernetes
+// Secret and keeps them up to date on a poll interval.
+type InfisicalSecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InfisicalSecretSpec   `json:"spec,omitempty"`
+	Status InfisicalSecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InfisicalSecretList contains a list of InfisicalSecret.
+type InfisicalSecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InfisicalSecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InfisicalSecret{}, &InfisicalSecretList{})
+}
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConflictPolicy governs what happens when two selected sources contribute
+// the same key.
+// +kubebuilder:validation:Enum=Error;Overwrite;SkipExisting;Prefix
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyError fails the push/merge when a key collides.
+	ConflictPolicyError ConflictPolicy = "Error"
+	// ConflictPolicyOverwrite lets the later source (by selector match order)
+	// win.
+	ConflictPolicyOverwrite ConflictPolicy = "Overwrite"
+	// ConflictPolicySkipExisting keeps whichever source produced the key first.
+	ConflictPolicySkipExisting ConflictPolicy = "SkipExisting"
+	// ConflictPolicyPrefix disambiguates colliding keys by prefixing them
+	// with their source's name.
+	ConflictPolicyPrefix ConflictPolicy = "Prefix"
+)
+
+// DeletionPolicy governs what happens to previously-pushed keys once their
+// source Secret/ConfigMap disappears.
+// +kubebuilder:validation:Enum=Retain;Delete
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyRetain leaves previously pushed keys in place.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyDelete removes upstream keys whose source vanished.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+)
+
+// SecretSelector selects a set of Kubernetes Secrets/ConfigMaps in a
+// namespace by label and/or annotation, instead of naming a single source.
+//
+// No InfisicalPushSecret/InfisicalSecret reconciler exists in this tree yet
+// to evaluate a SecretSelector (see common.MatchSelectedData), so setting
+// SecretPush.Selector currently has no effect.
+type SecretSelector struct {
+	// LabelSelector matches objects by label.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// AnnotationSelector matches objects by annotation, using the same
+	// matching semantics as LabelSelector.
+	// +optional
+	AnnotationSelector *metav1.LabelSelector `json:"annotationSelector,omitempty"`
+}
+
+// SecretSourceSelector selects an Infisical scope/environment to merge into
+// an InfisicalSecret's managed Secret, alongside any other configured sources.
+//
+// Likewise unwired: no reconciler in this tree calls common.MergeSources, so
+// InfisicalSecretSpec.Sources is currently a no-op.
+type SecretSourceSelector struct {
+	// EnvSlug selects the environment this source reads from.
+	// +optional
+	EnvSlug string `json:"envSlug,omitempty"`
+	// FolderPath is a glob matched against Infisical folder paths within
+	// EnvSlug.
+	// +optional
+	FolderPath string `json:"folderPath,omitempty"`
+	// Tags, when set, restricts this source to secrets carrying all of the
+	// listed tags.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+	// Prefix is prepended to every key this source contributes.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// Priority orders sources during merge: higher priority sources win key
+	// collisions. Sources with equal priority are merged in spec order.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
@@ -0,0 +1,37 @@
+package v1alpha1
+
+// TemplateEngine selects how InfisicalSecretTemplate.Data (or TemplateFrom)
+// values are turned into the managed Secret's data.
+// +kubebuilder:validation:Enum=None;Go
+type TemplateEngine string
+
+const (
+	// TemplateEngineNone copies values verbatim, the historical behavior.
+	TemplateEngineNone TemplateEngine = "None"
+	// TemplateEngineGo evaluates each value as a Go text/template expression.
+	TemplateEngineGo TemplateEngine = "Go"
+)
+
+// ConfigMapTemplateSource loads a template from a key in a ConfigMap, for
+// templates too large to inline in the CR.
+type ConfigMapTemplateSource struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Key       string `json:"key"`
+}
+
+// SecretTemplateSource loads a template from a key in a Secret.
+type SecretTemplateSource struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Key       string `json:"key"`
+}
+
+// TemplateSource points at an externally-stored template, as an alternative
+// to inlining it in InfisicalSecretTemplate.Data.
+type TemplateSource struct {
+	// +optional
+	ConfigMapRef *ConfigMapTemplateSource `json:"configMapRef,omitempty"`
+	// +optional
+	SecretRef *SecretTemplateSource `json:"secretRef,omitempty"`
+}
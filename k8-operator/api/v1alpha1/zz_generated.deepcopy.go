@@ -42,17 +42,73 @@ func (in *AWSIamAuthDetails) DeepCopy() *AWSIamAuthDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthIssuerFrontend) DeepCopyInto(out *AuthIssuerFrontend) {
+	*out = *in
+	if in.UniversalAuth != nil {
+		in, out := &in.UniversalAuth, &out.UniversalAuth
+		*out = new(UniversalAuthDetails)
+		**out = **in
+	}
+	if in.ServiceToken != nil {
+		in, out := &in.ServiceToken, &out.ServiceToken
+		*out = new(ServiceTokenDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccountDetails)
+		**out = **in
+	}
+	if in.KubernetesAuth != nil {
+		in, out := &in.KubernetesAuth, &out.KubernetesAuth
+		*out = new(KubernetesAuthDetails)
+		**out = **in
+	}
+	if in.AwsIamAuth != nil {
+		in, out := &in.AwsIamAuth, &out.AwsIamAuth
+		*out = new(AWSIamAuthDetails)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthIssuerFrontend.
+func (in *AuthIssuerFrontend) DeepCopy() *AuthIssuerFrontend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthIssuerFrontend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthIssuerReference) DeepCopyInto(out *AuthIssuerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthIssuerReference.
+func (in *AuthIssuerReference) DeepCopy() *AuthIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authentication) DeepCopyInto(out *Authentication) {
 	*out = *in
 	out.ServiceAccount = in.ServiceAccount
-	out.ServiceToken = in.ServiceToken
+	in.ServiceToken.DeepCopyInto(&out.ServiceToken)
 	out.UniversalAuth = in.UniversalAuth
 	out.KubernetesAuth = in.KubernetesAuth
 	out.AwsIamAuth = in.AwsIamAuth
 	out.AzureAuth = in.AzureAuth
 	out.GcpIdTokenAuth = in.GcpIdTokenAuth
 	out.GcpIamAuth = in.GcpIamAuth
+	in.ExecAuth.DeepCopyInto(&out.ExecAuth)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authentication.
@@ -96,6 +152,21 @@ func (in *CaReference) DeepCopy() *CaReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapTemplateSource) DeepCopyInto(out *ConfigMapTemplateSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapTemplateSource.
+func (in *ConfigMapTemplateSource) DeepCopy() *ConfigMapTemplateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapTemplateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicSecretDetails) DeepCopyInto(out *DynamicSecretDetails) {
 	*out = *in
@@ -111,6 +182,89 @@ func (in *DynamicSecretDetails) DeepCopy() *DynamicSecretDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicSecretHooks) DeepCopyInto(out *DynamicSecretHooks) {
+	*out = *in
+	if in.OnLeaseCreated != nil {
+		in, out := &in.OnLeaseCreated, &out.OnLeaseCreated
+		*out = make([]LeaseHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OnLeaseRenewed != nil {
+		in, out := &in.OnLeaseRenewed, &out.OnLeaseRenewed
+		*out = make([]LeaseHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OnLeaseRevoked != nil {
+		in, out := &in.OnLeaseRevoked, &out.OnLeaseRevoked
+		*out = make([]LeaseHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OnLeaseExpired != nil {
+		in, out := &in.OnLeaseExpired, &out.OnLeaseExpired
+		*out = make([]LeaseHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSecretHooks.
+func (in *DynamicSecretHooks) DeepCopy() *DynamicSecretHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicSecretHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvVar) DeepCopyInto(out *EnvVar) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvVar.
+func (in *EnvVar) DeepCopy() *EnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecAuthDetails) DeepCopyInto(out *ExecAuthDetails) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]EnvVar, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecAuthDetails.
+func (in *ExecAuthDetails) DeepCopy() *ExecAuthDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecAuthDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPIdTokenAuthDetails) DeepCopyInto(out *GCPIdTokenAuthDetails) {
 	*out = *in
@@ -212,6 +366,7 @@ func (in *GenericInfisicalAuthentication) DeepCopyInto(out *GenericInfisicalAuth
 	out.AzureAuth = in.AzureAuth
 	out.GcpIdTokenAuth = in.GcpIdTokenAuth
 	out.GcpIamAuth = in.GcpIamAuth
+	in.ExecAuth.DeepCopyInto(&out.ExecAuth)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericInfisicalAuthentication.
@@ -256,6 +411,131 @@ func (in *GenericUniversalAuth) DeepCopy() *GenericUniversalAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookExecutionStatus) DeepCopyInto(out *HookExecutionStatus) {
+	*out = *in
+	if in.LastExecutionTime != nil {
+		in, out := &in.LastExecutionTime, &out.LastExecutionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookExecutionStatus.
+func (in *HookExecutionStatus) DeepCopy() *HookExecutionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HookExecutionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfisicalAuthIssuer) DeepCopyInto(out *InfisicalAuthIssuer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalAuthIssuer.
+func (in *InfisicalAuthIssuer) DeepCopy() *InfisicalAuthIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(InfisicalAuthIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfisicalAuthIssuer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfisicalAuthIssuerList) DeepCopyInto(out *InfisicalAuthIssuerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InfisicalAuthIssuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalAuthIssuerList.
+func (in *InfisicalAuthIssuerList) DeepCopy() *InfisicalAuthIssuerList {
+	if in == nil {
+		return nil
+	}
+	out := new(InfisicalAuthIssuerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfisicalAuthIssuerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfisicalAuthIssuerSpec) DeepCopyInto(out *InfisicalAuthIssuerSpec) {
+	*out = *in
+	in.Frontend.DeepCopyInto(&out.Frontend)
+	in.TLS.DeepCopyInto(&out.TLS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalAuthIssuerSpec.
+func (in *InfisicalAuthIssuerSpec) DeepCopy() *InfisicalAuthIssuerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InfisicalAuthIssuerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfisicalAuthIssuerStatus) DeepCopyInto(out *InfisicalAuthIssuerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSuccessfulLogin != nil {
+		in, out := &in.LastSuccessfulLogin, &out.LastSuccessfulLogin
+		*out = (*in).DeepCopy()
+	}
+	if in.TokenExpiresAt != nil {
+		in, out := &in.TokenExpiresAt, &out.TokenExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalAuthIssuerStatus.
+func (in *InfisicalAuthIssuerStatus) DeepCopy() *InfisicalAuthIssuerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfisicalAuthIssuerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfisicalDynamicSecret) DeepCopyInto(out *InfisicalDynamicSecret) {
 	*out = *in
@@ -336,9 +616,15 @@ func (in *InfisicalDynamicSecretList) DeepCopyObject() runtime.Object {
 func (in *InfisicalDynamicSecretSpec) DeepCopyInto(out *InfisicalDynamicSecretSpec) {
 	*out = *in
 	in.ManagedSecretReference.DeepCopyInto(&out.ManagedSecretReference)
-	out.Authentication = in.Authentication
+	in.Authentication.DeepCopyInto(&out.Authentication)
 	out.DynamicSecret = in.DynamicSecret
-	out.TLS = in.TLS
+	in.TLS.DeepCopyInto(&out.TLS)
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(DynamicSecretHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	out.PreExpiryWindow = in.PreExpiryWindow
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalDynamicSecretSpec.
@@ -366,6 +652,13 @@ func (in *InfisicalDynamicSecretStatus) DeepCopyInto(out *InfisicalDynamicSecret
 		*out = new(InfisicalDynamicSecretLease)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HookConditions != nil {
+		in, out := &in.HookConditions, &out.HookConditions
+		*out = make([]HookExecutionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalDynamicSecretStatus.
@@ -456,9 +749,9 @@ func (in *InfisicalPushSecretList) DeepCopyObject() runtime.Object {
 func (in *InfisicalPushSecretSpec) DeepCopyInto(out *InfisicalPushSecretSpec) {
 	*out = *in
 	out.Destination = in.Destination
-	out.Authentication = in.Authentication
-	out.Push = in.Push
-	out.TLS = in.TLS
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	in.Push.DeepCopyInto(&out.Push)
+	in.TLS.DeepCopyInto(&out.TLS)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalPushSecretSpec.
@@ -563,9 +856,21 @@ func (in *InfisicalSecretList) DeepCopyObject() runtime.Object {
 func (in *InfisicalSecretSpec) DeepCopyInto(out *InfisicalSecretSpec) {
 	*out = *in
 	out.TokenSecretReference = in.TokenSecretReference
-	out.Authentication = in.Authentication
+	in.Authentication.DeepCopyInto(&out.Authentication)
 	in.ManagedSecretReference.DeepCopyInto(&out.ManagedSecretReference)
-	out.TLS = in.TLS
+	in.TLS.DeepCopyInto(&out.TLS)
+	if in.AuthIssuerRef != nil {
+		in, out := &in.AuthIssuerRef, &out.AuthIssuerRef
+		*out = new(AuthIssuerReference)
+		**out = **in
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]SecretSourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalSecretSpec.
@@ -610,6 +915,11 @@ func (in *InfisicalSecretTemplate) DeepCopyInto(out *InfisicalSecretTemplate) {
 			(*out)[key] = val
 		}
 	}
+	if in.TemplateFrom != nil {
+		in, out := &in.TemplateFrom, &out.TemplateFrom
+		*out = new(TemplateSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfisicalSecretTemplate.
@@ -622,6 +932,22 @@ func (in *InfisicalSecretTemplate) DeepCopy() *InfisicalSecretTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobHook) DeepCopyInto(out *JobHook) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobHook.
+func (in *JobHook) DeepCopy() *JobHook {
+	if in == nil {
+		return nil
+	}
+	out := new(JobHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeSecretReference) DeepCopyInto(out *KubeSecretReference) {
 	*out = *in
@@ -669,6 +995,36 @@ func (in *KubernetesServiceAccountRef) DeepCopy() *KubernetesServiceAccountRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaseHook) DeepCopyInto(out *LeaseHook) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingRestart != nil {
+		in, out := &in.RollingRestart, &out.RollingRestart
+		*out = new(RollingRestartHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseHook.
+func (in *LeaseHook) DeepCopy() *LeaseHook {
+	if in == nil {
+		return nil
+	}
+	out := new(LeaseHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineIdentityScopeInWorkspace) DeepCopyInto(out *MachineIdentityScopeInWorkspace) {
 	*out = *in
@@ -704,10 +1060,31 @@ func (in *ManagedKubeSecretConfig) DeepCopy() *ManagedKubeSecretConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingRestartHook) DeepCopyInto(out *RollingRestartHook) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingRestartHook.
+func (in *RollingRestartHook) DeepCopy() *RollingRestartHook {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingRestartHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretPush) DeepCopyInto(out *SecretPush) {
 	*out = *in
-	out.Secret = in.Secret
+	in.Secret.DeepCopyInto(&out.Secret)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(SecretSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretPush.
@@ -723,6 +1100,11 @@ func (in *SecretPush) DeepCopy() *SecretPush {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretScopeInWorkspace) DeepCopyInto(out *SecretScopeInWorkspace) {
 	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(SecretSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretScopeInWorkspace.
@@ -735,6 +1117,66 @@ func (in *SecretScopeInWorkspace) DeepCopy() *SecretScopeInWorkspace {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSelector) DeepCopyInto(out *SecretSelector) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnnotationSelector != nil {
+		in, out := &in.AnnotationSelector, &out.AnnotationSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSelector.
+func (in *SecretSelector) DeepCopy() *SecretSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSourceSelector) DeepCopyInto(out *SecretSourceSelector) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSourceSelector.
+func (in *SecretSourceSelector) DeepCopy() *SecretSourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretTemplateSource) DeepCopyInto(out *SecretTemplateSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplateSource.
+func (in *SecretTemplateSource) DeepCopy() *SecretTemplateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretTemplateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountDetails) DeepCopyInto(out *ServiceAccountDetails) {
 	*out = *in
@@ -755,7 +1197,7 @@ func (in *ServiceAccountDetails) DeepCopy() *ServiceAccountDetails {
 func (in *ServiceTokenDetails) DeepCopyInto(out *ServiceTokenDetails) {
 	*out = *in
 	out.ServiceTokenSecretReference = in.ServiceTokenSecretReference
-	out.SecretsScope = in.SecretsScope
+	in.SecretsScope.DeepCopyInto(&out.SecretsScope)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTokenDetails.
@@ -772,6 +1214,13 @@ func (in *ServiceTokenDetails) DeepCopy() *ServiceTokenDetails {
 func (in *TLSConfig) DeepCopyInto(out *TLSConfig) {
 	*out = *in
 	out.CaRef = in.CaRef
+	out.ClientCertRef = in.ClientCertRef
+	out.ClientKeyRef = in.ClientKeyRef
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfig.
@@ -784,6 +1233,31 @@ func (in *TLSConfig) DeepCopy() *TLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateSource) DeepCopyInto(out *TemplateSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapTemplateSource)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretTemplateSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateSource.
+func (in *TemplateSource) DeepCopy() *TemplateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UniversalAuthDetails) DeepCopyInto(out *UniversalAuthDetails) {
 	*out = *in
@@ -800,3 +1274,35 @@ func (in *UniversalAuthDetails) DeepCopy() *UniversalAuthDetails {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookHook) DeepCopyInto(out *WebhookHook) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CaRef != nil {
+		in, out := &in.CaRef, &out.CaRef
+		*out = new(CaReference)
+		**out = **in
+	}
+	if in.HMACSecretRef != nil {
+		in, out := &in.HMACSecretRef, &out.HMACSecretRef
+		*out = new(KubeSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookHook.
+func (in *WebhookHook) DeepCopy() *WebhookHook {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookHook)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,148 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+// ExecCredential is the stdout contract an ExecAuth plugin must implement,
+// modeled on client-go's client.authentication.k8s.io ExecCredential.
+type ExecCredential struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Status     ExecCredentialStatus `json:"status"`
+}
+
+// ExecCredentialStatus carries either a ready-to-use Infisical access token
+// or raw universal-auth credentials for the operator to exchange itself.
+type ExecCredentialStatus struct {
+	Token               string     `json:"token,omitempty"`
+	ClientID            string     `json:"clientId,omitempty"`
+	ClientSecret        string     `json:"clientSecret,omitempty"`
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp,omitempty"`
+}
+
+// execAuthCacheEntry is an in-memory cache slot keyed by execAuthCacheKey,
+// kept until the returned credential's expiry.
+type execAuthCacheEntry struct {
+	status    ExecCredentialStatus
+	expiresAt time.Time
+}
+
+// execAuthCacheKey identifies a unique plugin invocation. Two
+// InfisicalSecrets that happen to share a Command must not share a cache
+// entry if their Args/Env/APIVersion differ, since those differences can
+// select an entirely different identity.
+func execAuthCacheKey(details v1alpha1.ExecAuthDetails) string {
+	h := sha256.New()
+	fmt.Fprintln(h, details.Command)
+	fmt.Fprintln(h, details.Args)
+	for _, env := range details.Env {
+		fmt.Fprintln(h, env.Name, env.Value)
+	}
+	fmt.Fprintln(h, details.APIVersion)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ExecAuthCache caches ExecAuth plugin output in-memory, keyed by the full
+// plugin invocation (see execAuthCacheKey), so the plugin is only re-invoked
+// once the previously issued credential nears expiry.
+//
+// Nothing in this tree calls GetCredentials yet: the InfisicalSecret and
+// InfisicalDynamicSecret reconcilers that would read Authentication.ExecAuth
+// and invoke it live outside this package/chunk.
+type ExecAuthCache struct {
+	mu      sync.Mutex
+	entries map[string]execAuthCacheEntry
+
+	// invokeFunc defaults to (*ExecAuthCache).invoke; overridable in tests.
+	invokeFunc func(ctx context.Context, details v1alpha1.ExecAuthDetails) (ExecCredentialStatus, error)
+}
+
+// NewExecAuthCache returns an empty ExecAuthCache.
+func NewExecAuthCache() *ExecAuthCache {
+	c := &ExecAuthCache{entries: make(map[string]execAuthCacheEntry)}
+	c.invokeFunc = c.invoke
+	return c
+}
+
+// defaultExpiryLeeway is subtracted from a credential's reported expiry so a
+// refresh is triggered slightly before the credential actually goes stale.
+const defaultExpiryLeeway = 30 * time.Second
+
+// GetCredentials returns cached credentials for details if they are still
+// fresh, otherwise it re-invokes the configured exec plugin and caches the
+// result.
+func (c *ExecAuthCache) GetCredentials(ctx context.Context, details v1alpha1.ExecAuthDetails) (ExecCredentialStatus, error) {
+	if details.Command == "" {
+		return ExecCredentialStatus{}, fmt.Errorf("execAuth: command is required")
+	}
+
+	key := execAuthCacheKey(details)
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.status, nil
+	}
+
+	status, err := c.invokeFunc(ctx, details)
+	if err != nil {
+		return ExecCredentialStatus{}, err
+	}
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	if status.ExpirationTimestamp != nil {
+		expiresAt = status.ExpirationTimestamp.Add(-defaultExpiryLeeway)
+	}
+
+	c.mu.Lock()
+	c.entries[key] = execAuthCacheEntry{status: status, expiresAt: expiresAt}
+	c.mu.Unlock()
+
+	return status, nil
+}
+
+// invoke runs the configured command and parses its stdout as an
+// ExecCredential. It never reads stdin (InteractiveMode is always "Never").
+func (c *ExecAuthCache) invoke(ctx context.Context, details v1alpha1.ExecAuthDetails) (ExecCredentialStatus, error) {
+	cmd := exec.CommandContext(ctx, details.Command, details.Args...)
+	cmd.Env = os.Environ()
+	for _, env := range details.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", env.Name, env.Value))
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		hint := ""
+		if details.InstallHint != "" {
+			hint = ": " + details.InstallHint
+		}
+		return ExecCredentialStatus{}, fmt.Errorf("execAuth: %q failed%s: %w (stderr: %s)", details.Command, hint, err, stderr.String())
+	}
+
+	var cred ExecCredential
+	if err := json.Unmarshal(stdout.Bytes(), &cred); err != nil {
+		return ExecCredentialStatus{}, fmt.Errorf("execAuth: could not parse ExecCredential from %q: %w", details.Command, err)
+	}
+
+	if cred.Status.Token == "" && (cred.Status.ClientID == "" || cred.Status.ClientSecret == "") {
+		return ExecCredentialStatus{}, fmt.Errorf("execAuth: %q returned neither a token nor clientId/clientSecret", details.Command)
+	}
+
+	return cred.Status, nil
+}
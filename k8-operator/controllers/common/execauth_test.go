@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+func TestExecAuthCacheGetCredentialsCachesUntilExpiry(t *testing.T) {
+	calls := 0
+	cache := NewExecAuthCache()
+	cache.invokeFunc = func(ctx context.Context, details v1alpha1.ExecAuthDetails) (ExecCredentialStatus, error) {
+		calls++
+		expiry := time.Now().Add(time.Hour)
+		return ExecCredentialStatus{Token: "tok", ExpirationTimestamp: &expiry}, nil
+	}
+
+	details := v1alpha1.ExecAuthDetails{Command: "/bin/fake-plugin"}
+
+	first, err := cache.GetCredentials(context.Background(), details)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Token != "tok" {
+		t.Fatalf("expected token %q, got %q", "tok", first.Token)
+	}
+
+	if _, err := cache.GetCredentials(context.Background(), details); err != nil {
+		t.Fatalf("unexpected error on cached call: %v", err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected plugin to be invoked once, got %d calls", calls)
+	}
+}
+
+func TestExecAuthCacheGetCredentialsRequiresCommand(t *testing.T) {
+	cache := NewExecAuthCache()
+	if _, err := cache.GetCredentials(context.Background(), v1alpha1.ExecAuthDetails{}); err == nil {
+		t.Fatal("expected an error when Command is empty")
+	}
+}
+
+func TestExecAuthCacheGetCredentialsKeyedBeyondCommand(t *testing.T) {
+	calls := 0
+	cache := NewExecAuthCache()
+	cache.invokeFunc = func(ctx context.Context, details v1alpha1.ExecAuthDetails) (ExecCredentialStatus, error) {
+		calls++
+		expiry := time.Now().Add(time.Hour)
+		return ExecCredentialStatus{Token: details.Args[0], ExpirationTimestamp: &expiry}, nil
+	}
+
+	first, err := cache.GetCredentials(context.Background(), v1alpha1.ExecAuthDetails{Command: "/bin/fake-plugin", Args: []string{"identity-a"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.Token != "identity-a" {
+		t.Fatalf("expected token %q, got %q", "identity-a", first.Token)
+	}
+
+	second, err := cache.GetCredentials(context.Background(), v1alpha1.ExecAuthDetails{Command: "/bin/fake-plugin", Args: []string{"identity-b"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.Token != "identity-b" {
+		t.Fatalf("expected a different identity's Args to bypass the first cache entry, got %q", second.Token)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected the plugin to be invoked once per distinct Args, got %d calls", calls)
+	}
+}
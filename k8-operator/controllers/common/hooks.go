@@ -0,0 +1,239 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+// HookExecutionFailedReason is the Condition reason set on
+// InfisicalDynamicSecretStatus when a lease-lifecycle hook fails to run.
+const HookExecutionFailedReason = "HookExecutionFailed"
+
+// hmacSecretDataKey is the fixed data key read off WebhookHook.HMACSecretRef.
+const hmacSecretDataKey = "hmacSecret"
+
+// hmacSignatureHeader carries the hex-encoded HMAC-SHA256 signature of the
+// webhook request body.
+const hmacSignatureHeader = "X-Infisical-Signature"
+
+// LeaseHookEvent names the lease-lifecycle boundary a hook fired at.
+type LeaseHookEvent string
+
+const (
+	LeaseHookEventCreated LeaseHookEvent = "Created"
+	LeaseHookEventRenewed LeaseHookEvent = "Renewed"
+	LeaseHookEventRevoked LeaseHookEvent = "Revoked"
+	LeaseHookEventExpired LeaseHookEvent = "Expired"
+)
+
+// LeaseHookMetadata is the non-sensitive lease information surfaced to hooks
+// (webhook bodies/headers, Job envFrom). It never carries the credential
+// itself.
+//
+// BuildWebhookRequest, BuildJobForHook and ApplyRollingRestart below are
+// building blocks only: the InfisicalDynamicSecret reconciler that would
+// fire them at lease boundaries and record HookExecutionStatus isn't part
+// of this tree.
+type LeaseHookMetadata struct {
+	LeaseId           string         `json:"leaseId"`
+	Event             LeaseHookEvent `json:"event"`
+	CreationTimestamp metav1.Time    `json:"creationTimestamp"`
+	ExpiresAt         metav1.Time    `json:"expiresAt"`
+}
+
+// BuildWebhookRequest renders hook.Headers as Go templates against metadata,
+// JSON-encodes metadata as the request body, and HMAC-SHA256 signs it when
+// hook.HMACSecretRef is set. c/namespace are only used to resolve
+// HMACSecretRef; namespace is the InfisicalDynamicSecret's own namespace.
+func BuildWebhookRequest(ctx context.Context, c client.Client, namespace string, hook v1alpha1.WebhookHook, metadata LeaseHookMetadata) (*http.Request, error) {
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("hooks: marshaling lease metadata: %w", err)
+	}
+
+	method := hook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("hooks: building request for %q: %w", hook.URL, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	for key, expr := range hook.Headers {
+		value, err := renderHookTemplate(key, expr, metadata)
+		if err != nil {
+			return nil, fmt.Errorf("hooks: header %q: %w", key, err)
+		}
+		req.Header.Set(key, value)
+	}
+
+	if hook.HMACSecretRef != nil {
+		secret, err := fetchHMACSecret(ctx, c, namespace, hook.HMACSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(body)
+		req.Header.Set(hmacSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	return req, nil
+}
+
+func renderHookTemplate(name, expr string, metadata LeaseHookMetadata) (string, error) {
+	t, err := template.New(name).Parse(expr)
+	if err != nil {
+		return "", fmt.Errorf("parsing template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, metadata); err != nil {
+		return "", fmt.Errorf("executing template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func fetchHMACSecret(ctx context.Context, c client.Client, namespace string, ref *v1alpha1.KubeSecretReference) ([]byte, error) {
+	ns := ref.SecretNamespace
+	if ns == "" {
+		ns = namespace
+	}
+	var secret corev1.Secret
+	key := client.ObjectKey{Name: ref.SecretName, Namespace: ns}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		return nil, fmt.Errorf("hooks: fetching hmacSecretRef %s/%s: %w", ns, ref.SecretName, err)
+	}
+	value, ok := secret.Data[hmacSecretDataKey]
+	if !ok {
+		return nil, fmt.Errorf("hooks: secret %s/%s has no %q key", ns, ref.SecretName, hmacSecretDataKey)
+	}
+	return value, nil
+}
+
+// BuildJobForHook decodes hook.Template into a batch/v1 Job, defaults its
+// namespace, and injects metadata as a generated ConfigMap referenced via
+// envFrom on every container so the Job can read the lease metadata without
+// the operator templating arbitrary fields into the Job spec.
+func BuildJobForHook(hook v1alpha1.JobHook, defaultNamespace string, metadata LeaseHookMetadata) (*batchv1.Job, *corev1.ConfigMap, error) {
+	var job batchv1.Job
+	if err := json.Unmarshal(hook.Template.Raw, &job); err != nil {
+		return nil, nil, fmt.Errorf("hooks: decoding job template: %w", err)
+	}
+
+	namespace := hook.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	job.Namespace = namespace
+
+	configMapName := job.Name
+	if configMapName == "" {
+		configMapName = job.GenerateName
+	}
+	if configMapName == "" {
+		return nil, nil, fmt.Errorf("hooks: job template must set name or generateName")
+	}
+	configMapName += "-lease-metadata"
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			"LEASE_ID":         metadata.LeaseId,
+			"LEASE_EVENT":      string(metadata.Event),
+			"LEASE_CREATED_AT": metadata.CreationTimestamp.Format(time.RFC3339),
+			"LEASE_EXPIRES_AT": metadata.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+
+	envFrom := corev1.EnvFromSource{ConfigMapRef: &corev1.ConfigMapEnvSource{
+		LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+	}}
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].EnvFrom = append(job.Spec.Template.Spec.Containers[i].EnvFrom, envFrom)
+	}
+
+	return &job, configMap, nil
+}
+
+// ApplyRollingRestart lists every Deployment/StatefulSet in hook's namespace
+// (defaulting to defaultNamespace) matching hook.Selector and patches a
+// restart-trigger annotation on its pod template, mirroring `kubectl rollout
+// restart`.
+func ApplyRollingRestart(ctx context.Context, c client.Client, defaultNamespace string, hook v1alpha1.RollingRestartHook) error {
+	namespace := hook.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	if len(hook.Selector.MatchLabels) == 0 && len(hook.Selector.MatchExpressions) == 0 {
+		return fmt.Errorf("hooks: rollingRestart selector must not be empty, refusing to restart every workload in namespace %q", namespace)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&hook.Selector)
+	if err != nil {
+		return fmt.Errorf("hooks: invalid rollingRestart selector: %w", err)
+	}
+
+	listOpts := []client.ListOption{client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}}
+	restartedAt := time.Now().Format(time.RFC3339)
+
+	switch hook.Kind {
+	case "Deployment":
+		var list appsv1.DeploymentList
+		if err := c.List(ctx, &list, listOpts...); err != nil {
+			return fmt.Errorf("hooks: listing deployments: %w", err)
+		}
+		for i := range list.Items {
+			annotatePodTemplate(&list.Items[i].Spec.Template, restartedAt)
+			if err := c.Update(ctx, &list.Items[i]); err != nil {
+				return fmt.Errorf("hooks: restarting deployment %q: %w", list.Items[i].Name, err)
+			}
+		}
+	case "StatefulSet":
+		var list appsv1.StatefulSetList
+		if err := c.List(ctx, &list, listOpts...); err != nil {
+			return fmt.Errorf("hooks: listing statefulsets: %w", err)
+		}
+		for i := range list.Items {
+			annotatePodTemplate(&list.Items[i].Spec.Template, restartedAt)
+			if err := c.Update(ctx, &list.Items[i]); err != nil {
+				return fmt.Errorf("hooks: restarting statefulset %q: %w", list.Items[i].Name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("hooks: rollingRestart.kind must be Deployment or StatefulSet, got %q", hook.Kind)
+	}
+
+	return nil
+}
+
+// restartedAtAnnotation is patched onto a pod template to force a rollout,
+// mirroring what `kubectl rollout restart` does.
+const restartedAtAnnotation = "infisical.com/restartedAt"
+
+func annotatePodTemplate(template *corev1.PodTemplateSpec, restartedAt string) {
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[restartedAtAnnotation] = restartedAt
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+func TestBuildWebhookRequestRendersHeaderTemplate(t *testing.T) {
+	metadata := LeaseHookMetadata{LeaseId: "lease-123", Event: LeaseHookEventRenewed}
+	hook := v1alpha1.WebhookHook{
+		URL:     "https://example.com/hooks",
+		Headers: map[string]string{"X-Lease-Id": "{{ .LeaseId }}"},
+	}
+
+	req, err := BuildWebhookRequest(context.Background(), nil, "default", hook, metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := req.Header.Get("X-Lease-Id"); got != "lease-123" {
+		t.Fatalf("expected header to render LeaseId, got %q", got)
+	}
+	if req.Header.Get(hmacSignatureHeader) != "" {
+		t.Fatal("expected no signature header without HMACSecretRef")
+	}
+}
+
+func TestBuildWebhookRequestSignsBodyWhenHMACSecretSet(t *testing.T) {
+	metadata := LeaseHookMetadata{LeaseId: "lease-abc", Event: LeaseHookEventCreated}
+	fakeClient := &singleSecretClient{secret: corev1.Secret{
+		Data: map[string][]byte{hmacSecretDataKey: []byte("shh")},
+	}}
+	hook := v1alpha1.WebhookHook{
+		URL:           "https://example.com/hooks",
+		HMACSecretRef: &v1alpha1.KubeSecretReference{SecretName: "hmac", SecretNamespace: "default"},
+	}
+
+	req, err := BuildWebhookRequest(context.Background(), fakeClient, "default", hook, metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte("shh"))
+	mac.Write(body)
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	if got := req.Header.Get(hmacSignatureHeader); got != want {
+		t.Fatalf("expected signature %q, got %q", want, got)
+	}
+}
+
+func TestBuildJobForHookInjectsLeaseMetadataConfigMap(t *testing.T) {
+	job := batchv1.Job{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "rotator"}},
+				},
+			},
+		},
+	}
+	job.Name = "rotate-lease"
+	raw, err := json.Marshal(job)
+	if err != nil {
+		t.Fatalf("marshaling job: %v", err)
+	}
+
+	hook := v1alpha1.JobHook{Template: runtime.RawExtension{Raw: raw}}
+	metadata := LeaseHookMetadata{LeaseId: "lease-xyz", Event: LeaseHookEventExpired}
+
+	builtJob, configMap, err := BuildJobForHook(hook, "fallback-ns", metadata)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if builtJob.Namespace != "fallback-ns" {
+		t.Fatalf("expected namespace to default to fallback-ns, got %q", builtJob.Namespace)
+	}
+	if configMap.Data["LEASE_ID"] != "lease-xyz" {
+		t.Fatalf("expected lease metadata in configmap, got %v", configMap.Data)
+	}
+
+	envFrom := builtJob.Spec.Template.Spec.Containers[0].EnvFrom
+	if len(envFrom) != 1 || envFrom[0].ConfigMapRef.Name != configMap.Name {
+		t.Fatalf("expected container to reference the lease metadata configmap, got %v", envFrom)
+	}
+}
+
+func TestBuildJobForHookRejectsUnnamedTemplate(t *testing.T) {
+	raw, err := json.Marshal(batchv1.Job{})
+	if err != nil {
+		t.Fatalf("marshaling job: %v", err)
+	}
+
+	hook := v1alpha1.JobHook{Template: runtime.RawExtension{Raw: raw}}
+	if _, _, err := BuildJobForHook(hook, "fallback-ns", LeaseHookMetadata{}); err == nil {
+		t.Fatal("expected an error when the job template sets neither name nor generateName")
+	}
+}
+
+func TestApplyRollingRestartRejectsEmptySelector(t *testing.T) {
+	hook := v1alpha1.RollingRestartHook{Kind: "Deployment"}
+	if err := ApplyRollingRestart(context.Background(), nil, "default", hook); err == nil {
+		t.Fatal("expected an error for an empty selector instead of matching every workload")
+	}
+}
+
+// singleSecretClient is a minimal client.Client stub that answers Get with a
+// fixed Secret, for tests that only exercise HMAC-secret resolution.
+type singleSecretClient struct {
+	client.Client
+	secret corev1.Secret
+}
+
+func (c *singleSecretClient) Get(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+	*secret = c.secret
+	return nil
+}
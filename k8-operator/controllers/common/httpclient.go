@@ -0,0 +1,147 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+// TLSConfigMissingCertReason is the Condition reason a caller should set on
+// its resource's Status.Conditions when ClientCertRef/ClientKeyRef can't be
+// resolved to a usable key pair.
+const TLSConfigMissingCertReason = "TLSClientCertificateMissing"
+
+var tlsMinVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// BuildHTTPClient resolves cfg's CaRef/ClientCertRef/ClientKeyRef Secrets out
+// of each reference's own SecretNamespace (falling back to namespace when it
+// is unset) and returns an *http.Client configured to talk to the Infisical API
+// per cfg.ServerName/MinVersion/CipherSuites.
+func BuildHTTPClient(ctx context.Context, c client.Client, namespace string, cfg v1alpha1.TLSConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{ServerName: cfg.ServerName}
+
+	if cfg.MinVersion != "" {
+		minVersion, ok := tlsMinVersions[cfg.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("tls: unsupported minVersion %q", cfg.MinVersion)
+		}
+		tlsConfig.MinVersion = minVersion
+	}
+
+	if len(cfg.CipherSuites) > 0 {
+		suites, err := resolveCipherSuites(cfg.CipherSuites)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.CipherSuites = suites
+	}
+
+	if cfg.CaRef.SecretName != "" {
+		pool, err := loadCAPool(ctx, c, namespace, cfg.CaRef)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertRef.SecretName != "" || cfg.ClientKeyRef.SecretName != "" {
+		cert, err := loadClientCertificate(ctx, c, namespace, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", TLSConfigMissingCertReason, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}, nil
+}
+
+func resolveCipherSuites(names []string) ([]uint16, error) {
+	byName := make(map[string]uint16, len(tls.CipherSuites()))
+	for _, suite := range tls.CipherSuites() {
+		byName[suite.Name] = suite.ID
+	}
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("tls: unknown cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func loadCAPool(ctx context.Context, c client.Client, namespace string, ref v1alpha1.CaReference) (*x509.CertPool, error) {
+	ns := ref.SecretNamespace
+	if ns == "" {
+		ns = namespace
+	}
+	key := ref.Key
+	if key == "" {
+		key = "ca.crt"
+	}
+
+	pemBytes, err := fetchSecretKey(ctx, c, ns, ref.SecretName, key)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemBytes) {
+		return nil, fmt.Errorf("tls: caRef %s/%s key %q is not a valid PEM certificate", ns, ref.SecretName, key)
+	}
+	return pool, nil
+}
+
+func loadClientCertificate(ctx context.Context, c client.Client, namespace string, cfg v1alpha1.TLSConfig) (tls.Certificate, error) {
+	if cfg.ClientCertRef.SecretName == "" || cfg.ClientKeyRef.SecretName == "" {
+		return tls.Certificate{}, fmt.Errorf("tls: clientCertRef and clientKeyRef must both be set")
+	}
+
+	certNamespace := cfg.ClientCertRef.SecretNamespace
+	if certNamespace == "" {
+		certNamespace = namespace
+	}
+	certPEM, err := fetchSecretKey(ctx, c, certNamespace, cfg.ClientCertRef.SecretName, "tls.crt")
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	keyNamespace := cfg.ClientKeyRef.SecretNamespace
+	if keyNamespace == "" {
+		keyNamespace = namespace
+	}
+	keyPEM, err := fetchSecretKey(ctx, c, keyNamespace, cfg.ClientKeyRef.SecretName, "tls.key")
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("tls: parsing client key pair: %w", err)
+	}
+	return cert, nil
+}
+
+func fetchSecretKey(ctx context.Context, c client.Client, namespace, secretName, key string) ([]byte, error) {
+	var secret corev1.Secret
+	if err := c.Get(ctx, client.ObjectKey{Name: secretName, Namespace: namespace}, &secret); err != nil {
+		return nil, fmt.Errorf("tls: fetching %s/%s: %w", namespace, secretName, err)
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("tls: secret %s/%s has no %q key", namespace, secretName, key)
+	}
+	return data, nil
+}
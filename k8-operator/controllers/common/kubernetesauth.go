@@ -0,0 +1,185 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+// defaultKubernetesAuthAudience is used when KubernetesAuthDetails.Audience
+// is unset.
+const defaultKubernetesAuthAudience = "infisical"
+
+// projectedTokenExpirationSeconds is the lifetime requested for a
+// TokenRequest-issued projected ServiceAccount token.
+const projectedTokenExpirationSeconds = int64(600)
+
+// KubernetesAuthLoginResponse is the relevant subset of the response from
+// POST /api/v1/auth/kubernetes-auth/login.
+type KubernetesAuthLoginResponse struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresIn   int64  `json:"expiresIn"`
+}
+
+type kubernetesAuthCacheEntry struct {
+	token     string
+	expiresAt time.Time
+}
+
+// KubernetesAuthCache exchanges a projected ServiceAccount token for an
+// Infisical machine-identity access token via the Kubernetes-native auth
+// flow, caching the result per IdentityID until it nears expiry.
+type KubernetesAuthCache struct {
+	mu      sync.Mutex
+	entries map[string]kubernetesAuthCacheEntry
+
+	httpClient *http.Client
+	apiBaseURL string
+
+	// loginFunc defaults to (*KubernetesAuthCache).login; overridable in tests.
+	loginFunc func(ctx context.Context, jwt string, details v1alpha1.KubernetesAuthDetails) (KubernetesAuthLoginResponse, error)
+}
+
+// NewKubernetesAuthCache returns an empty KubernetesAuthCache that logs into
+// apiBaseURL using httpClient (see BuildHTTPClient for one configured from a
+// TLSConfig).
+func NewKubernetesAuthCache(httpClient *http.Client, apiBaseURL string) *KubernetesAuthCache {
+	c := &KubernetesAuthCache{
+		entries:    make(map[string]kubernetesAuthCacheEntry),
+		httpClient: httpClient,
+		apiBaseURL: strings.TrimSuffix(apiBaseURL, "/"),
+	}
+	c.loginFunc = c.login
+	return c
+}
+
+// GetAccessToken returns a cached Infisical access token for details (and
+// its remaining lifetime) if it's still fresh, otherwise it resolves a
+// projected ServiceAccount token (via TokenPath or the TokenRequest API)
+// and exchanges it at the kubernetes-auth login endpoint, caching the
+// result until it nears expiry.
+func (c *KubernetesAuthCache) GetAccessToken(ctx context.Context, k8sClient client.Client, details v1alpha1.KubernetesAuthDetails) (string, time.Duration, error) {
+	if details.IdentityID == "" {
+		return "", 0, fmt.Errorf("kubernetesAuth: identityId is required")
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[details.IdentityID]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.token, time.Until(entry.expiresAt), nil
+	}
+
+	jwt, err := ServiceAccountJWT(ctx, k8sClient, details)
+	if err != nil {
+		return "", 0, err
+	}
+
+	resp, err := c.loginFunc(ctx, jwt, details)
+	if err != nil {
+		return "", 0, err
+	}
+
+	expiresAt := time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - defaultExpiryLeeway)
+
+	c.mu.Lock()
+	c.entries[details.IdentityID] = kubernetesAuthCacheEntry{token: resp.AccessToken, expiresAt: expiresAt}
+	c.mu.Unlock()
+
+	return resp.AccessToken, time.Until(expiresAt), nil
+}
+
+// ServiceAccountJWT returns a projected ServiceAccount token for details,
+// reading it from TokenPath when set, otherwise requesting one via the
+// TokenRequest API against details.ServiceAccountRef.
+func ServiceAccountJWT(ctx context.Context, k8sClient client.Client, details v1alpha1.KubernetesAuthDetails) (string, error) {
+	if details.TokenPath != "" {
+		data, err := os.ReadFile(details.TokenPath)
+		if err != nil {
+			return "", fmt.Errorf("kubernetesAuth: reading tokenPath %q: %w", details.TokenPath, err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	audience := details.Audience
+	if audience == "" {
+		audience = defaultKubernetesAuthAudience
+	}
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      details.ServiceAccountRef.Name,
+			Namespace: details.ServiceAccountRef.Namespace,
+		},
+	}
+	expirationSeconds := projectedTokenExpirationSeconds
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{audience},
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+
+	if err := k8sClient.SubResource("token").Create(ctx, sa, tokenRequest); err != nil {
+		return "", fmt.Errorf("kubernetesAuth: requesting projected token for %s/%s: %w", details.ServiceAccountRef.Namespace, details.ServiceAccountRef.Name, err)
+	}
+
+	return tokenRequest.Status.Token, nil
+}
+
+func (c *KubernetesAuthCache) login(ctx context.Context, jwt string, details v1alpha1.KubernetesAuthDetails) (KubernetesAuthLoginResponse, error) {
+	body, err := json.Marshal(map[string]string{
+		"identityId": details.IdentityID,
+		"jwt":        jwt,
+	})
+	if err != nil {
+		return KubernetesAuthLoginResponse{}, fmt.Errorf("kubernetesAuth: marshaling login request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiBaseURL+"/api/v1/auth/kubernetes-auth/login", bytes.NewReader(body))
+	if err != nil {
+		return KubernetesAuthLoginResponse{}, fmt.Errorf("kubernetesAuth: building login request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return KubernetesAuthLoginResponse{}, fmt.Errorf("kubernetesAuth: calling kubernetes-auth/login: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return KubernetesAuthLoginResponse{}, fmt.Errorf("kubernetesAuth: reading login response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return KubernetesAuthLoginResponse{}, fmt.Errorf("kubernetesAuth: login returned %s: %s", resp.Status, string(respBody))
+	}
+
+	var login KubernetesAuthLoginResponse
+	if err := json.Unmarshal(respBody, &login); err != nil {
+		return KubernetesAuthLoginResponse{}, fmt.Errorf("kubernetesAuth: parsing login response: %w", err)
+	}
+
+	return login, nil
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+func TestServiceAccountJWTReadsTokenPath(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenPath, []byte("projected-jwt\n"), 0o600); err != nil {
+		t.Fatalf("writing fake token file: %v", err)
+	}
+
+	jwt, err := ServiceAccountJWT(context.Background(), nil, v1alpha1.KubernetesAuthDetails{TokenPath: tokenPath})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if jwt != "projected-jwt" {
+		t.Fatalf("expected trimmed token content, got %q", jwt)
+	}
+}
+
+func TestKubernetesAuthCacheGetAccessTokenCachesUntilExpiry(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenPath, []byte("projected-jwt"), 0o600); err != nil {
+		t.Fatalf("writing fake token file: %v", err)
+	}
+
+	calls := 0
+	cache := NewKubernetesAuthCache(nil, "https://app.infisical.com")
+	cache.loginFunc = func(ctx context.Context, jwt string, details v1alpha1.KubernetesAuthDetails) (KubernetesAuthLoginResponse, error) {
+		calls++
+		if jwt != "projected-jwt" {
+			t.Fatalf("expected the projected jwt to be passed through, got %q", jwt)
+		}
+		return KubernetesAuthLoginResponse{AccessToken: "access-tok", ExpiresIn: 3600}, nil
+	}
+
+	details := v1alpha1.KubernetesAuthDetails{IdentityID: "identity-1", TokenPath: tokenPath}
+
+	token, expiresIn, err := cache.GetAccessToken(context.Background(), nil, details)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "access-tok" {
+		t.Fatalf("expected access-tok, got %q", token)
+	}
+	if expiresIn <= 0 {
+		t.Fatalf("expected a positive remaining lifetime, got %v", expiresIn)
+	}
+
+	_, cachedExpiresIn, err := cache.GetAccessToken(context.Background(), nil, details)
+	if err != nil {
+		t.Fatalf("unexpected error on cached call: %v", err)
+	}
+	if cachedExpiresIn <= 0 {
+		t.Fatalf("expected a positive remaining lifetime on the cached call, got %v", cachedExpiresIn)
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected login to be invoked once, got %d calls", calls)
+	}
+}
+
+func TestKubernetesAuthCacheGetAccessTokenRequiresIdentityID(t *testing.T) {
+	cache := NewKubernetesAuthCache(nil, "https://app.infisical.com")
+	if _, _, err := cache.GetAccessToken(context.Background(), nil, v1alpha1.KubernetesAuthDetails{}); err == nil {
+		t.Fatal("expected an error when IdentityID is empty")
+	}
+}
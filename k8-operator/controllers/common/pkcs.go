@@ -0,0 +1,42 @@
+package common
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// pemToPkcs1 converts a PEM-encoded RSA private key (PKCS#1 or PKCS#8) into
+// legacy PKCS#1 PEM, for consumers (older JKS/keystore tooling, some nginx
+// builds) that don't understand PKCS#8.
+func pemToPkcs1(keyPEM string) (string, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return "", fmt.Errorf("pemToPkcs1: no PEM block found")
+	}
+
+	var rsaKey *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return keyPEM, nil
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return "", fmt.Errorf("pemToPkcs1: parsing PKCS#8 key: %w", err)
+		}
+		rk, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return "", fmt.Errorf("pemToPkcs1: key is not an RSA private key")
+		}
+		rsaKey = rk
+	default:
+		return "", fmt.Errorf("pemToPkcs1: unsupported PEM block type %q", block.Type)
+	}
+
+	out := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+	})
+	return string(out), nil
+}
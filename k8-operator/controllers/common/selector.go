@@ -0,0 +1,183 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+// MatchSelectedData lists every Secret and ConfigMap in namespace that
+// matches sel and returns each one's string data separately, keyed by
+// "Kind/name" (e.g. "Secret/foo"), so callers can apply a ConflictPolicy
+// (see MergeSelectedData) and a DeletionPolicy.
+//
+// MatchSelectedData, MergeSelectedData and MergeSources have no production
+// caller in this tree yet: the InfisicalPushSecret/InfisicalSecret
+// reconcilers that would use Selector/Sources to drive bulk push and
+// multi-source merge live outside this chunk.
+func MatchSelectedData(ctx context.Context, c client.Client, namespace string, sel v1alpha1.SecretSelector) (map[string]map[string]string, error) {
+	var labelSelector labels.Selector
+	var err error
+	if sel.LabelSelector != nil {
+		labelSelector, err = metav1.LabelSelectorAsSelector(sel.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("selector: invalid labelSelector: %w", err)
+		}
+	}
+
+	listOpts := []client.ListOption{client.InNamespace(namespace)}
+	if labelSelector != nil {
+		listOpts = append(listOpts, client.MatchingLabelsSelector{Selector: labelSelector})
+	}
+
+	out := make(map[string]map[string]string)
+
+	var secretList corev1.SecretList
+	if err := c.List(ctx, &secretList, listOpts...); err != nil {
+		return nil, fmt.Errorf("selector: listing secrets: %w", err)
+	}
+	for _, secret := range secretList.Items {
+		if !matchesAnnotationSelector(sel, secret.Annotations) {
+			continue
+		}
+		data := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			data[k] = string(v)
+		}
+		out["Secret/"+secret.Name] = data
+	}
+
+	var configMapList corev1.ConfigMapList
+	if err := c.List(ctx, &configMapList, listOpts...); err != nil {
+		return nil, fmt.Errorf("selector: listing configmaps: %w", err)
+	}
+	for _, cm := range configMapList.Items {
+		if !matchesAnnotationSelector(sel, cm.Annotations) {
+			continue
+		}
+		data := make(map[string]string, len(cm.Data))
+		for k, v := range cm.Data {
+			data[k] = v
+		}
+		out["ConfigMap/"+cm.Name] = data
+	}
+
+	return out, nil
+}
+
+func matchesAnnotationSelector(sel v1alpha1.SecretSelector, annotations map[string]string) bool {
+	if sel.AnnotationSelector == nil {
+		return true
+	}
+	annotationSelector, err := metav1.LabelSelectorAsSelector(sel.AnnotationSelector)
+	if err != nil {
+		return false
+	}
+	return annotationSelector.Matches(labels.Set(annotations))
+}
+
+// MergeSelectedData merges the per-source data returned by
+// MatchSelectedData into a single key/value map, applying policy on
+// collisions. sourceOrder fixes iteration order for SkipExisting/Overwrite
+// ties (typically the order sources were listed in).
+func MergeSelectedData(perSource map[string]map[string]string, sourceOrder []string, policy v1alpha1.ConflictPolicy) (map[string]string, error) {
+	if policy == "" {
+		policy = v1alpha1.ConflictPolicyError
+	}
+
+	order := sourceOrder
+	if len(order) == 0 {
+		for name := range perSource {
+			order = append(order, name)
+		}
+		sort.Strings(order)
+	}
+
+	merged := make(map[string]string)
+	for _, source := range order {
+		data, ok := perSource[source]
+		if !ok {
+			continue
+		}
+		for key, value := range data {
+			existing, collides := merged[key]
+			if !collides {
+				merged[key] = value
+				continue
+			}
+
+			switch policy {
+			case v1alpha1.ConflictPolicyOverwrite:
+				merged[key] = value
+			case v1alpha1.ConflictPolicySkipExisting:
+				_ = existing // keep the first value seen
+			case v1alpha1.ConflictPolicyPrefix:
+				merged[fmt.Sprintf("%s_%s", sourceObjectName(source), key)] = value
+			case v1alpha1.ConflictPolicyError:
+				fallthrough
+			default:
+				return nil, fmt.Errorf("selector: key %q collides between sources (conflictPolicy=%s)", key, policy)
+			}
+		}
+	}
+
+	return merged, nil
+}
+
+// sourceObjectName strips the "Kind/" prefix MatchSelectedData adds to its
+// map keys, returning the bare object name. Kubernetes Secret/ConfigMap data
+// keys must match [-._a-zA-Z0-9]+, so the "/" in "Secret/foo" can't be used
+// directly as a ConflictPolicyPrefix prefix.
+func sourceObjectName(source string) string {
+	if idx := strings.LastIndex(source, "/"); idx != -1 {
+		return source[idx+1:]
+	}
+	return source
+}
+
+// MergeSources merges per-source secret maps (keyed the same as the
+// SecretSourceSelector slice they came from) in Priority order, applying
+// each source's Prefix. Higher Priority wins key collisions; ties keep spec
+// order.
+func MergeSources(sources []v1alpha1.SecretSourceSelector, perSource []map[string]string) map[string]string {
+	type indexed struct {
+		idx      int
+		priority int32
+		data     map[string]string
+		prefix   string
+	}
+
+	entries := make([]indexed, 0, len(sources))
+	for i, src := range sources {
+		var data map[string]string
+		if i < len(perSource) {
+			data = perSource[i]
+		}
+		entries = append(entries, indexed{idx: i, priority: src.Priority, data: data, prefix: src.Prefix})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].priority < entries[j].priority
+	})
+
+	merged := make(map[string]string)
+	for _, e := range entries {
+		for k, v := range e.data {
+			key := k
+			if e.prefix != "" {
+				key = e.prefix + k
+			}
+			merged[key] = v
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+func TestMergeSelectedDataErrorsOnCollisionByDefault(t *testing.T) {
+	perSource := map[string]map[string]string{
+		"Secret/a": {"KEY": "a"},
+		"Secret/b": {"KEY": "b"},
+	}
+	if _, err := MergeSelectedData(perSource, []string{"Secret/a", "Secret/b"}, ""); err == nil {
+		t.Fatal("expected a collision error with the default ConflictPolicyError")
+	}
+}
+
+func TestMergeSelectedDataOverwriteLastWins(t *testing.T) {
+	perSource := map[string]map[string]string{
+		"Secret/a": {"KEY": "a"},
+		"Secret/b": {"KEY": "b"},
+	}
+	merged, err := MergeSelectedData(perSource, []string{"Secret/a", "Secret/b"}, v1alpha1.ConflictPolicyOverwrite)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if merged["KEY"] != "b" {
+		t.Fatalf("expected last source to win, got %q", merged["KEY"])
+	}
+}
+
+func TestMergeSelectedDataSkipExistingKeepsFirst(t *testing.T) {
+	perSource := map[string]map[string]string{
+		"Secret/a": {"KEY": "a"},
+		"Secret/b": {"KEY": "b"},
+	}
+	merged, err := MergeSelectedData(perSource, []string{"Secret/a", "Secret/b"}, v1alpha1.ConflictPolicySkipExisting)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if merged["KEY"] != "a" {
+		t.Fatalf("expected first source to win, got %q", merged["KEY"])
+	}
+}
+
+func TestMergeSelectedDataPrefixDisambiguates(t *testing.T) {
+	perSource := map[string]map[string]string{
+		"Secret/a": {"KEY": "a"},
+		"Secret/b": {"KEY": "b"},
+	}
+	merged, err := MergeSelectedData(perSource, []string{"Secret/a", "Secret/b"}, v1alpha1.ConflictPolicyPrefix)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if merged["KEY"] != "a" {
+		t.Fatalf("expected first source's key to stay unprefixed, got %q", merged["KEY"])
+	}
+	if merged["b_KEY"] != "b" {
+		t.Fatalf("expected second source's key to be prefixed with its bare object name, got merged=%v", merged)
+	}
+	for key := range merged {
+		if strings.Contains(key, "/") {
+			t.Fatalf("merged key %q is not a valid Secret/ConfigMap data key (contains '/')", key)
+		}
+	}
+}
+
+func TestMergeSourcesHigherPriorityWins(t *testing.T) {
+	sources := []v1alpha1.SecretSourceSelector{
+		{EnvSlug: "dev", Priority: 1},
+		{EnvSlug: "prod", Priority: 10},
+	}
+	perSource := []map[string]string{
+		{"KEY": "dev-value"},
+		{"KEY": "prod-value"},
+	}
+
+	merged := MergeSources(sources, perSource)
+	if merged["KEY"] != "prod-value" {
+		t.Fatalf("expected higher-priority source to win, got %q", merged["KEY"])
+	}
+}
+
+func TestMergeSourcesAppliesPrefix(t *testing.T) {
+	sources := []v1alpha1.SecretSourceSelector{
+		{EnvSlug: "dev", Prefix: "DEV_"},
+	}
+	perSource := []map[string]string{
+		{"KEY": "value"},
+	}
+
+	merged := MergeSources(sources, perSource)
+	if merged["DEV_KEY"] != "value" {
+		t.Fatalf("expected prefixed key, got merged=%v", merged)
+	}
+}
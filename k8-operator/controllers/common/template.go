@@ -0,0 +1,199 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+// TemplateRenderFailedReason is the Condition reason set on
+// InfisicalSecretStatus when a template fails to render, so operators can
+// tell a render error apart from a fetch/auth failure at a glance.
+const TemplateRenderFailedReason = "TemplateRenderFailed"
+
+// TemplateSecretValue is the per-key view of a fetched Infisical secret
+// exposed to templates as .Secrets.<KEY>.
+type TemplateSecretValue struct {
+	Value   string
+	Comment string
+}
+
+// templateFuncMap is the curated function set available to
+// InfisicalSecretTemplate expressions. It intentionally excludes
+// filesystem/network/env access (sprig's "env", "expandenv", etc) since
+// templates run with the operator's own privileges. It also omits a
+// "pkcs12" function (building Java keystores from secrets): encoding
+// PKCS#12 needs a dedicated library not vendored in this build, and a
+// function that can never succeed is worse than not offering it. Use
+// pemToPkcs1 plus an init container/sidecar for that case in the meantime.
+var templateFuncMap = template.FuncMap{
+	"b64enc": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+	"b64dec": func(s string) (string, error) {
+		out, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return "", fmt.Errorf("b64dec: %w", err)
+		}
+		return string(out), nil
+	},
+	"toJson": func(v interface{}) (string, error) {
+		out, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("toJson: %w", err)
+		}
+		return string(out), nil
+	},
+	"fromJson": func(s string) (interface{}, error) {
+		var v interface{}
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return nil, fmt.Errorf("fromJson: %w", err)
+		}
+		return v, nil
+	},
+	"toYaml": func(v interface{}) (string, error) {
+		out, err := yaml.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("toYaml: %w", err)
+		}
+		return strings.TrimSuffix(string(out), "\n"), nil
+	},
+	"fromYaml": func(s string) (interface{}, error) {
+		var v interface{}
+		if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+			return nil, fmt.Errorf("fromYaml: %w", err)
+		}
+		return v, nil
+	},
+	"pemToPkcs1": pemToPkcs1,
+	"default": func(defaultValue, value string) string {
+		if value == "" {
+			return defaultValue
+		}
+		return value
+	},
+	"trim": strings.TrimSpace,
+	"replace": func(old, new, s string) string {
+		return strings.ReplaceAll(s, old, new)
+	},
+	"hasKey": func(m map[string]interface{}, key string) bool {
+		_, ok := m[key]
+		return ok
+	},
+}
+
+// templateContext is the root object Go templates execute against.
+type templateContext struct {
+	Secrets     map[string]TemplateSecretValue
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// LoadTemplateFromSource resolves tpl.TemplateFrom, if set, into the
+// templateFromData RenderTemplate expects: the referenced ConfigMap/Secret
+// key is read as a YAML (or JSON, which is valid YAML) mapping of managed
+// Secret key to template expression, for templates too large to inline in
+// Data. Returns nil without error if tpl.TemplateFrom is unset.
+func LoadTemplateFromSource(ctx context.Context, c client.Client, namespace string, tpl v1alpha1.InfisicalSecretTemplate) (map[string]string, error) {
+	if tpl.TemplateFrom == nil {
+		return nil, nil
+	}
+
+	var raw string
+	switch {
+	case tpl.TemplateFrom.ConfigMapRef != nil:
+		ref := tpl.TemplateFrom.ConfigMapRef
+		ns := ref.Namespace
+		if ns == "" {
+			ns = namespace
+		}
+		var cm corev1.ConfigMap
+		if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ns}, &cm); err != nil {
+			return nil, fmt.Errorf("templateFrom: fetching configMap %s/%s: %w", ns, ref.Name, err)
+		}
+		value, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("templateFrom: configMap %s/%s has no %q key", ns, ref.Name, ref.Key)
+		}
+		raw = value
+	case tpl.TemplateFrom.SecretRef != nil:
+		ref := tpl.TemplateFrom.SecretRef
+		ns := ref.Namespace
+		if ns == "" {
+			ns = namespace
+		}
+		var secret corev1.Secret
+		if err := c.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ns}, &secret); err != nil {
+			return nil, fmt.Errorf("templateFrom: fetching secret %s/%s: %w", ns, ref.Name, err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("templateFrom: secret %s/%s has no %q key", ns, ref.Name, ref.Key)
+		}
+		raw = string(value)
+	default:
+		return nil, fmt.Errorf("templateFrom: neither configMapRef nor secretRef is set")
+	}
+
+	var data map[string]string
+	if err := yaml.Unmarshal([]byte(raw), &data); err != nil {
+		return nil, fmt.Errorf("templateFrom: referenced key is not a key/value mapping: %w", err)
+	}
+	return data, nil
+}
+
+// RenderTemplate renders every value in tpl.Data merged with
+// templateFromData (the referenced ConfigMap/Secret key when tpl.TemplateFrom
+// is set) as a Go text/template against secrets/labels/annotations. Keys in
+// tpl.Data take precedence over same-named keys from templateFromData. When
+// tpl.TemplateEngine is "None" or empty, values are returned verbatim.
+//
+// Neither RenderTemplate nor LoadTemplateFromSource is called from a
+// reconciler in this tree yet: the InfisicalSecret controller that would
+// call them to build the managed Secret's data lives outside this chunk.
+func RenderTemplate(tpl v1alpha1.InfisicalSecretTemplate, templateFromData map[string]string, secrets map[string]TemplateSecretValue, labels, annotations map[string]string) (map[string][]byte, error) {
+	data := make(map[string]string, len(templateFromData)+len(tpl.Data))
+	for k, v := range templateFromData {
+		data[k] = v
+	}
+	for k, v := range tpl.Data {
+		data[k] = v
+	}
+
+	rendered := make(map[string][]byte, len(data))
+
+	if tpl.TemplateEngine != v1alpha1.TemplateEngineGo {
+		for k, v := range data {
+			rendered[k] = []byte(v)
+		}
+		return rendered, nil
+	}
+
+	ctx := templateContext{Secrets: secrets, Labels: labels, Annotations: annotations}
+
+	for key, expr := range data {
+		t, err := template.New(key).Funcs(templateFuncMap).Parse(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: key %q: %w", TemplateRenderFailedReason, key, err)
+		}
+
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, ctx); err != nil {
+			return nil, fmt.Errorf("%s: key %q: %w", TemplateRenderFailedReason, key, err)
+		}
+
+		rendered[key] = buf.Bytes()
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+)
+
+func TestRenderTemplateNoneEngineCopiesVerbatim(t *testing.T) {
+	tpl := v1alpha1.InfisicalSecretTemplate{Data: map[string]string{"KEY": "{{ .Secrets.KEY.Value }}"}}
+
+	out, err := RenderTemplate(tpl, nil, map[string]TemplateSecretValue{"KEY": {Value: "hunter2"}}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out["KEY"]) != "{{ .Secrets.KEY.Value }}" {
+		t.Fatalf("expected literal copy, got %q", out["KEY"])
+	}
+}
+
+func TestRenderTemplateGoEngineRendersSecretsAndFuncs(t *testing.T) {
+	tpl := v1alpha1.InfisicalSecretTemplate{
+		TemplateEngine: v1alpha1.TemplateEngineGo,
+		Data: map[string]string{
+			"DOTENV": "DB_PASSWORD={{ .Secrets.DB_PASSWORD.Value | b64enc }}",
+		},
+	}
+
+	out, err := RenderTemplate(tpl, nil, map[string]TemplateSecretValue{"DB_PASSWORD": {Value: "hunter2"}}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = "DB_PASSWORD=aHVudGVyMg=="
+	if string(out["DOTENV"]) != want {
+		t.Fatalf("expected %q, got %q", want, out["DOTENV"])
+	}
+}
+
+func TestRenderTemplateGoEngineSurfacesParseError(t *testing.T) {
+	tpl := v1alpha1.InfisicalSecretTemplate{
+		TemplateEngine: v1alpha1.TemplateEngineGo,
+		Data:           map[string]string{"BROKEN": "{{ .Secrets.MISSING"},
+	}
+
+	if _, err := RenderTemplate(tpl, nil, nil, nil, nil); err == nil {
+		t.Fatal("expected a render error for malformed template syntax")
+	}
+}
+
+func TestRenderTemplateMergesDataAndTemplateFromByKey(t *testing.T) {
+	tpl := v1alpha1.InfisicalSecretTemplate{
+		TemplateEngine: v1alpha1.TemplateEngineGo,
+		Data: map[string]string{
+			"DOTENV": "DB_PASSWORD={{ .Secrets.DB_PASSWORD.Value }}",
+		},
+	}
+	templateFromData := map[string]string{
+		"DOTENV": "this should be shadowed by tpl.Data",
+		"NGINX":  "upstream {{ .Secrets.UPSTREAM.Value }};",
+	}
+
+	out, err := RenderTemplate(tpl, templateFromData, map[string]TemplateSecretValue{
+		"DB_PASSWORD": {Value: "hunter2"},
+		"UPSTREAM":    {Value: "backend:8080"},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out["DOTENV"]) != "DB_PASSWORD=hunter2" {
+		t.Fatalf("expected tpl.Data to take precedence, got %q", out["DOTENV"])
+	}
+	if string(out["NGINX"]) != "upstream backend:8080;" {
+		t.Fatalf("expected the templateFrom-only key to still render, got %q", out["NGINX"])
+	}
+}
+
+func TestLoadTemplateFromSourceReadsConfigMapKey(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-template", Namespace: "default"},
+		Data:       map[string]string{"template.yaml": "DOTENV: \"DB_PASSWORD={{ .Secrets.DB_PASSWORD.Value }}\"\n"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(cm).Build()
+
+	tpl := v1alpha1.InfisicalSecretTemplate{
+		TemplateFrom: &v1alpha1.TemplateSource{
+			ConfigMapRef: &v1alpha1.ConfigMapTemplateSource{Name: "my-template", Key: "template.yaml"},
+		},
+	}
+
+	data, err := LoadTemplateFromSource(context.Background(), fakeClient, "default", tpl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data["DOTENV"] != `DB_PASSWORD={{ .Secrets.DB_PASSWORD.Value }}` {
+		t.Fatalf("unexpected data: %v", data)
+	}
+}
+
+func TestLoadTemplateFromSourceNilWhenUnset(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build()
+
+	data, err := LoadTemplateFromSource(context.Background(), fakeClient, "default", v1alpha1.InfisicalSecretTemplate{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data != nil {
+		t.Fatalf("expected nil data when TemplateFrom is unset, got %v", data)
+	}
+}
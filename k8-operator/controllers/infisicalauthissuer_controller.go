@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/cloudcompute/infisical/k8-operator/api/v1alpha1"
+	"github.com/cloudcompute/infisical/k8-operator/controllers/common"
+)
+
+// AuthIssuerReadyConditionType is set on InfisicalAuthIssuerStatus.Conditions
+// to report whether Spec.Frontend currently resolves to a working Infisical
+// access token.
+const AuthIssuerReadyConditionType = "Ready"
+
+const (
+	// AuthIssuerLoginSucceededReason is set when Frontend was successfully
+	// exchanged for an access token.
+	AuthIssuerLoginSucceededReason = "LoginSucceeded"
+	// AuthIssuerLoginFailedReason is set when the exchange itself failed
+	// (bad credentials, unreachable API, etc).
+	AuthIssuerLoginFailedReason = "LoginFailed"
+	// AuthIssuerFrontendNotImplementedReason is set when Spec.Frontend uses
+	// an authentication mode this controller doesn't yet know how to
+	// exchange for a token.
+	AuthIssuerFrontendNotImplementedReason = "FrontendNotImplemented"
+)
+
+// DefaultInfisicalAPIBaseURL is used when an InfisicalAuthIssuerReconciler is
+// constructed without an explicit override, matching the Infisical Cloud
+// default.
+const DefaultInfisicalAPIBaseURL = "https://app.infisical.com"
+
+// defaultAuthIssuerRequeueInterval is used when a successful login didn't
+// yield a token lifetime to derive a refresh interval from.
+const defaultAuthIssuerRequeueInterval = 5 * time.Minute
+
+// InfisicalAuthIssuerReconciler reconciles an InfisicalAuthIssuer by
+// exchanging Spec.Frontend for an Infisical access token and recording the
+// outcome on Status. MapAuthIssuerToInfisicalSecrets maps an issuer update to
+// the InfisicalSecrets referencing it; this tree contains no InfisicalSecret
+// reconciler that registers it.
+type InfisicalAuthIssuerReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	APIBaseURL string
+
+	mu         sync.Mutex
+	authCaches map[types.NamespacedName]*common.KubernetesAuthCache
+}
+
+// NewInfisicalAuthIssuerReconciler returns a reconciler that exchanges
+// credentials against apiBaseURL (DefaultInfisicalAPIBaseURL if empty).
+func NewInfisicalAuthIssuerReconciler(c client.Client, scheme *runtime.Scheme, apiBaseURL string) *InfisicalAuthIssuerReconciler {
+	if apiBaseURL == "" {
+		apiBaseURL = DefaultInfisicalAPIBaseURL
+	}
+	return &InfisicalAuthIssuerReconciler{
+		Client:     c,
+		Scheme:     scheme,
+		APIBaseURL: apiBaseURL,
+		authCaches: make(map[types.NamespacedName]*common.KubernetesAuthCache),
+	}
+}
+
+// +kubebuilder:rbac:groups=secrets.infisical.com,resources=infisicalauthissuers,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=secrets.infisical.com,resources=infisicalauthissuers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=secrets.infisical.com,resources=infisicalsecrets,verbs=get;list;watch
+
+// Reconcile exchanges issuer.Spec.Frontend for an access token, records the
+// outcome as a Ready condition (plus LastSuccessfulLogin/TokenExpiresAt on
+// success), and requeues itself ahead of expiry.
+func (r *InfisicalAuthIssuerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var issuer v1alpha1.InfisicalAuthIssuer
+	if err := r.Get(ctx, req.NamespacedName, &issuer); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	_, expiresIn, loginErr := r.login(ctx, req.NamespacedName, issuer)
+
+	now := metav1.Now()
+	condition := metav1.Condition{
+		Type:               AuthIssuerReadyConditionType,
+		ObservedGeneration: issuer.Generation,
+	}
+	if loginErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = AuthIssuerLoginFailedReason
+		if issuer.Spec.Frontend.KubernetesAuth == nil {
+			condition.Reason = AuthIssuerFrontendNotImplementedReason
+		}
+		condition.Message = loginErr.Error()
+	} else {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = AuthIssuerLoginSucceededReason
+		condition.Message = "Frontend resolved to a working Infisical access token"
+		issuer.Status.LastSuccessfulLogin = &now
+		expiresAt := metav1.NewTime(now.Add(expiresIn))
+		issuer.Status.TokenExpiresAt = &expiresAt
+	}
+	apimeta.SetStatusCondition(&issuer.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, &issuer); err != nil {
+		return ctrl.Result{}, fmt.Errorf("infisicalAuthIssuer: updating status: %w", err)
+	}
+
+	if loginErr != nil {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	requeueAfter := defaultAuthIssuerRequeueInterval
+	if expiresIn > 0 {
+		requeueAfter = expiresIn / 2
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// login exchanges issuer.Spec.Frontend for an access token and the
+// remaining lifetime it was issued with. Only KubernetesAuth is implemented
+// today; other frontend modes report
+// AuthIssuerFrontendNotImplementedReason rather than silently succeeding.
+func (r *InfisicalAuthIssuerReconciler) login(ctx context.Context, name types.NamespacedName, issuer v1alpha1.InfisicalAuthIssuer) (string, time.Duration, error) {
+	frontend := issuer.Spec.Frontend
+	switch {
+	case frontend.KubernetesAuth != nil:
+		httpClient, err := common.BuildHTTPClient(ctx, r.Client, issuer.Namespace, issuer.Spec.TLS)
+		if err != nil {
+			return "", 0, fmt.Errorf("building TLS client: %w", err)
+		}
+		token, expiresIn, err := r.kubernetesAuthCache(name, httpClient).GetAccessToken(ctx, r.Client, *frontend.KubernetesAuth)
+		if err != nil {
+			return "", 0, err
+		}
+		return token, expiresIn, nil
+	default:
+		return "", 0, fmt.Errorf("%s: InfisicalAuthIssuer only supports the kubernetesAuth frontend today", AuthIssuerFrontendNotImplementedReason)
+	}
+}
+
+// kubernetesAuthCache returns the long-lived KubernetesAuthCache for issuer
+// name, creating one (backed by httpClient) on first use so successive
+// reconciles benefit from its token cache instead of logging in every time.
+func (r *InfisicalAuthIssuerReconciler) kubernetesAuthCache(name types.NamespacedName, httpClient *http.Client) *common.KubernetesAuthCache {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cache, ok := r.authCaches[name]
+	if !ok {
+		cache = common.NewKubernetesAuthCache(httpClient, r.APIBaseURL)
+		r.authCaches[name] = cache
+	}
+	return cache
+}
+
+// MapAuthIssuerToInfisicalSecrets returns a controller-runtime MapFunc an
+// InfisicalSecretReconciler's SetupWithManager should register via
+// Watches(&v1alpha1.InfisicalAuthIssuer{}, handler.EnqueueRequestsFromMapFunc(...))
+// so that an InfisicalAuthIssuer update (e.g. this reconciler refreshing its
+// token) re-reconciles every InfisicalSecret referencing it through
+// AuthIssuerRef, instead of waiting out that InfisicalSecret's own poll
+// interval.
+func MapAuthIssuerToInfisicalSecrets(c client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		issuer, ok := obj.(*v1alpha1.InfisicalAuthIssuer)
+		if !ok {
+			return nil
+		}
+
+		var secrets v1alpha1.InfisicalSecretList
+		if err := c.List(ctx, &secrets); err != nil {
+			return nil
+		}
+
+		var requests []ctrl.Request
+		for _, secret := range secrets.Items {
+			ref := secret.Spec.AuthIssuerRef
+			if ref == nil || ref.Name != issuer.Name {
+				continue
+			}
+			ns := ref.Namespace
+			if ns == "" {
+				ns = secret.Namespace
+			}
+			if ns != issuer.Namespace {
+				continue
+			}
+			requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}})
+		}
+		return requests
+	}
+}
+
+func (r *InfisicalAuthIssuerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.InfisicalAuthIssuer{}).
+		Complete(r)
+}